@@ -0,0 +1,302 @@
+package graph
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// adjacency struct is the word-neighbor graph of the dictionary, materialized from the trie so that the siblings
+// of a word can be read in O(degree), instead of probing the trie for each candidate.
+//
+// Each word is given an integer ID, and its siblings are kept as a compact list of IDs, sorted alphabetically.
+// When built, IDs follow the alphabetical order of the words; words added afterwards take the IDs of removed words
+// (or new ones), so that the graph is kept up to date without rebuilding it (see `insert()` and `remove()`).
+type adjacency struct {
+	words []string         // ID to word; empty for the IDs of removed words
+	ids   map[string]int32 // word to ID
+	lists [][]int32        // ID to the IDs of its siblings, sorted alphabetically
+	free  []int32          // IDs of removed words, to be reused
+}
+
+// newAdjacency function will build the word-neighbor graph for the input root node, with the same sibling
+// relationship as the trie-based `probe()`:
+//   - words of the same length which differ in a single position are connected through wildcard buckets; for
+//     example, "cat" and "cot" are both in the "c_t" bucket for the second position;
+//   - words which are one character longer than another word (with the same prefix) are connected to it;
+//     for example, "cat" and "cats".
+func newAdjacency(root *Node) *adjacency {
+	words := root.words()
+
+	a := &adjacency{
+		words: words,
+		ids:   make(map[string]int32, len(words)),
+		lists: make([][]int32, len(words)),
+	}
+
+	// positions are counted in characters (runes), not bytes
+	var maxLen int
+	chars := make([][]rune, len(words))
+
+	for idx, w := range words {
+		a.ids[w] = int32(idx)
+		chars[idx] = []rune(w)
+
+		if len(chars[idx]) > maxLen {
+			maxLen = len(chars[idx])
+		}
+	}
+
+	// connect the words in the same wildcard bucket, one position at a time; as the wildcard is always in the same
+	// position, a literal "_" in the words cannot be mistaken for it
+	for pos := 0; pos < maxLen; pos++ {
+		buckets := map[string][]int32{}
+
+		for idx, w := range chars {
+			if len(w) <= pos {
+				continue
+			}
+
+			key := string(w[:pos]) + "_" + string(w[pos+1:])
+			buckets[key] = append(buckets[key], int32(idx))
+		}
+
+		for _, bucket := range buckets {
+			for _, from := range bucket {
+				for _, to := range bucket {
+					if from != to {
+						a.lists[from] = append(a.lists[from], to)
+					}
+				}
+			}
+		}
+	}
+
+	// connect the words with the ones one character shorter
+	for idx, w := range chars {
+		if len(w) < 2 {
+			continue
+		}
+
+		if prefix, ok := a.ids[string(w[:len(w)-1])]; ok {
+			a.lists[prefix] = append(a.lists[prefix], int32(idx))
+			a.lists[idx] = append(a.lists[idx], prefix)
+		}
+	}
+
+	// sort the siblings by ID, which is alphabetical order at this point
+	for _, list := range a.lists {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i] < list[j]
+		})
+	}
+
+	return a
+}
+
+// insert method will add a word (which must exist in the input root node's trie) to the graph, connecting it to
+// its siblings as found by `probe()`; so that only the new word's neighborhood is looked up. It returns the new
+// word's ID, or false if it was already in the graph.
+func (a *adjacency) insert(root *Node, word string) (int32, bool) {
+	if _, ok := a.ids[word]; ok {
+		return 0, false
+	}
+
+	var id int32
+
+	if len(a.free) > 0 {
+		id = a.free[len(a.free)-1]
+		a.free = a.free[:len(a.free)-1]
+		a.words[id] = word
+	} else {
+		id = int32(len(a.words))
+		a.words = append(a.words, word)
+		a.lists = append(a.lists, nil)
+	}
+
+	a.ids[word] = id
+
+	// a word without siblings is a dead-end
+	siblings, _ := root.probe(word)
+
+	for _, s := range siblings {
+		sid, ok := a.ids[s]
+
+		if !ok {
+			continue
+		}
+
+		a.link(id, sid)
+		a.link(sid, id)
+	}
+
+	return id, true
+}
+
+// remove method will remove a word from the graph, disconnecting it from its siblings. It returns the removed
+// word's ID and the IDs of its former siblings, or false if it was not in the graph.
+func (a *adjacency) remove(word string) (int32, []int32, bool) {
+	id, ok := a.ids[word]
+
+	if !ok {
+		return 0, nil, false
+	}
+
+	siblings := a.lists[id]
+
+	for _, sid := range siblings {
+		a.unlink(sid, id)
+	}
+
+	delete(a.ids, word)
+	a.words[id] = ""
+	a.lists[id] = nil
+	a.free = append(a.free, id)
+
+	return id, siblings, true
+}
+
+// link method will add the word with ID to to the siblings of the word with ID from, keeping them sorted
+// alphabetically
+func (a *adjacency) link(from, to int32) {
+	list := a.lists[from]
+	idx := sort.Search(len(list), func(i int) bool {
+		return a.words[list[i]] >= a.words[to]
+	})
+
+	list = append(list, 0)
+	copy(list[idx+1:], list[idx:])
+	list[idx] = to
+
+	a.lists[from] = list
+}
+
+// unlink method will remove the word with ID to from the siblings of the word with ID from
+func (a *adjacency) unlink(from, to int32) {
+	list := a.lists[from]
+
+	for idx, id := range list {
+		if id == to {
+			a.lists[from] = append(list[:idx:idx], list[idx+1:]...)
+			return
+		}
+	}
+}
+
+// siblingIDs method will return the IDs of the siblings of the input word, sorted alphabetically; or false if the
+// word does not exist in the dictionary. The returned slice must not be modified.
+func (a *adjacency) siblingIDs(word string) ([]int32, bool) {
+	id, ok := a.ids[word]
+
+	if !ok {
+		return nil, false
+	}
+
+	return a.lists[id], true
+}
+
+// neighbors method will return the siblings of the input word, sorted alphabetically; or nil if it does not exist
+// in the dictionary or has no siblings
+func (a *adjacency) neighbors(word string) []string {
+	ids, _ := a.siblingIDs(word)
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out := make([]string, len(ids))
+	for idx, id := range ids {
+		out[idx] = a.words[id]
+	}
+
+	return out
+}
+
+// fuzz method will return the siblings of the input word in the same order as `probe()` lists them: the
+// substitutions (by position, then by character), followed by the words with one more character (by character),
+// and then the word with one less character. It returns nil if the word does not exist in the dictionary or has
+// no siblings.
+func (a *adjacency) fuzz(word string) []string {
+	out := a.neighbors(word)
+
+	// as the siblings are sorted alphabetically, sorting them by type of change and position (and keeping the
+	// order on a tie) leaves each position's substitutions sorted by character
+	sort.SliceStable(out, func(i, j int) bool {
+		ki, pi := change(word, out[i])
+		kj, pj := change(word, out[j])
+
+		if ki != kj {
+			return ki < kj
+		}
+
+		return pi < pj
+	})
+
+	return out
+}
+
+// change function will return the type of change from the word to its sibling (as an Operation) and the position
+// of the changed character, counted in characters (runes)
+func change(word, sibling string) (Operation, int) {
+	wordLen := utf8.RuneCountInString(word)
+	siblingLen := utf8.RuneCountInString(sibling)
+
+	switch {
+	case siblingLen > wordLen:
+		return OpInsert, wordLen
+	case siblingLen < wordLen:
+		return OpDelete, siblingLen
+	}
+
+	pos := 0
+	for _, char := range word {
+		next, size := utf8.DecodeRuneInString(sibling)
+
+		if char != next {
+			break
+		}
+
+		sibling = sibling[size:]
+		pos++
+	}
+
+	return OpSubstitute, pos
+}
+
+// adjacency method will return the word-neighbor graph of the dictionary, building it if it is not built yet
+func (n *Node) adjacency() *adjacency {
+	root := n.getRoot()
+	idx := root.state().index
+
+	// a root node not created with New() has nowhere to keep the graph
+	if idx == nil {
+		return newAdjacency(root)
+	}
+
+	if a, _ := idx.adjacency.Load().(*adjacency); a != nil {
+		return a
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	// it may have been built while waiting for the lock
+	if a, _ := idx.adjacency.Load().(*adjacency); a != nil {
+		return a
+	}
+
+	a := newAdjacency(root)
+	idx.adjacency.Store(a)
+
+	return a
+}
+
+// Build method will build the indexes over the whole dictionary: the word-neighbor graph that `Fuzz()`, `Siblings()`
+// and all route queries read from, and the connected-component index. Otherwise, they are built the first time
+// they are needed; calling Build() after loading the dictionary moves this cost out of the first query.
+//
+// Once built, the indexes are kept up to date as words are added to (or removed from) the dictionary, by only
+// updating the neighborhoods of the changed words, so there is no need to call Build() again. Removing a word may
+// split its connected component, in which case only the words on the smaller side of the split are relabeled.
+func (n *Node) Build() {
+	_ = n.components()
+}
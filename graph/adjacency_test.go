@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"testing"
+)
+
+func TestAdjacency(t *testing.T) {
+	module := "Adjacency"
+	funcname := "Fuzz()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		words []string
+	}
+
+	var tests = []test{
+		{
+			name:  "small dictionary",
+			words: []string{"cat", "dog", "pat", "cot", "fur", "cats", "ca", "c_t", "c_ts"},
+		},
+		{
+			name:  "dense dictionary",
+			words: append(combinations("abc", 3), combinations("abc", 2)...),
+		},
+		{
+			name:  "words of many lengths",
+			words: []string{"a", "ab", "abc", "abcd", "abcde", "b", "bb", "bbc", "abd", "abdd", "xbcde"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		root := New()
+		root.Add(test.words...)
+
+		for _, w := range root.words() {
+			probed, _ := root.probe(w)
+			siblings, _ := root.Fuzz(w)
+
+			// the graph must hold the same siblings as probing the trie
+			sorted := append([]string{}, siblings...)
+			sort.Strings(probed)
+			sort.Strings(sorted)
+
+			if len(probed) == 0 {
+				probed = nil
+			}
+
+			if len(sorted) == 0 {
+				sorted = nil
+			}
+
+			if !reflect.DeepEqual(sorted, probed) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] siblings mismatch error for %q: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					w,
+					probed,
+					sorted,
+					test.name,
+				)
+				return
+			}
+
+			// siblings are listed by type of change and position, then by character
+			for i := 1; i < len(siblings); i++ {
+				ki, pi := change(w, siblings[i-1])
+				kj, pj := change(w, siblings[i])
+
+				if ki > kj || ki == kj && (pi > pj || pi == pj && siblings[i-1] > siblings[i]) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] siblings out of order for %q: got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						w,
+						siblings,
+						test.name,
+					)
+					return
+				}
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestAdjacencyAdd(t *testing.T) {
+	module := "Adjacency"
+	funcname := "Add() >> Siblings()"
+
+	_ = module
+	_ = funcname
+
+	root := New()
+	root.Add("cat", "cot")
+	root.Build()
+
+	// adding words updates the graph in place, so the new siblings show up without building it again
+	root.Add("pat", "cats")
+
+	siblings, err := root.Siblings("cat")
+	wants := []string{"pat", "cot", "cats"}
+
+	if err != nil || !reflect.DeepEqual(siblings, wants) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v (%v) -- action: %s",
+			0,
+			module,
+			funcname,
+			wants,
+			siblings,
+			err,
+			"siblings after adding words",
+		)
+	}
+}
+
+func BenchmarkBuild(b *testing.B) {
+	root, _, _ := benchmarkDictionary(b)
+	words := root.words()
+
+	// each run builds the indexes of a new dictionary with the same words, leaving the trie out of it
+	fresh := func() *Node {
+		n := New()
+		n.Add(words...)
+
+		return n
+	}
+
+	b.Run("Build", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			n := fresh()
+			b.StartTimer()
+
+			n.Build()
+		}
+	})
+
+	b.Run("Memory", func(b *testing.B) {
+		var before, after runtime.MemStats
+
+		for i := 0; i < b.N; i++ {
+			n := fresh()
+
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+
+			n.Build()
+
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+
+			// keep the dictionary (and its indexes) alive until measured
+			runtime.KeepAlive(n)
+		}
+
+		b.ReportMetric(float64(after.HeapAlloc)-float64(before.HeapAlloc), "heap-B")
+		b.ReportMetric(float64(len(words)), "words")
+	})
+}
+
+func BenchmarkSiblings(b *testing.B) {
+	root, origin, _ := benchmarkDictionary(b)
+	root.Build()
+
+	b.Run("Probe", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = root.probe(origin)
+		}
+	})
+
+	b.Run("Graph", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = root.Siblings(origin)
+		}
+	})
+
+	b.Run("TargetSiblings", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			_, _ = root.TargetSiblings(origin, origin)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"container/heap"
+	"context"
+)
+
+// aStar method will look up the shortest route from the origin to the target word, by always expanding
+// the most promising word first: the one with the smallest sum of steps taken so far and estimated steps
+// left to reach the target.
+//
+// The estimate is taken from `letterDistance()`, which never over-estimates the remaining number of steps,
+// so the first time the target is expanded the route leading to it is guaranteed to be the shortest one.
+// Compared to a breadth-first search, words which drift away from the target are left for last (and are
+// usually never expanded at all).
+//
+// Ties are broken by the estimate, and then alphabetically, so the same query against the same dictionary
+// will always return the same route. The query halts once the input context is done, returning its error.
+//
+// If a maximum depth is configured, words are not explored past it.
+func (n *Node) aStar(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	// work on the root for a complete word look-up
+	node := n.getRoot()
+
+	visited := map[string]string{origin: ""} // word to previous word
+	steps := map[string]int{origin: 0}       // word to the lowest number of steps to reach it
+	expanded := map[string]struct{}{}        // words whose siblings were already explored
+
+	open := &frontier{}
+	heap.Push(open, &candidate{
+		word:     origin,
+		steps:    0,
+		estimate: letterDistance(origin, target),
+	})
+
+	for open.Len() > 0 {
+		// halt the query once the context is done
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		c := heap.Pop(open).(*candidate)
+
+		// once the target is expanded, its route is the shortest one
+		if c.word == target {
+			return backtrack(visited, origin, target), nil
+		}
+
+		// skip stale entries for words that were reached with fewer steps afterwards
+		if _, ok := expanded[c.word]; ok {
+			continue
+		}
+		expanded[c.word] = struct{}{}
+
+		cfg.stats.Expanded++
+		siblings, err := node.allowedNeighbors(cfg, c.word)
+
+		// a word without siblings is a dead-end
+		if err != nil {
+			continue
+		}
+
+		for _, sibling := range siblings {
+			next := c.steps + 1
+
+			// skip words past the maximum depth (steps do not include the origin)
+			if cfg.maxDepth > 0 && next+1 > cfg.maxDepth {
+				continue
+			}
+
+			// skip words that were already reached with the same or fewer steps
+			if s, ok := steps[sibling]; ok && s <= next {
+				continue
+			}
+
+			steps[sibling] = next
+			visited[sibling] = c.word
+
+			heap.Push(open, &candidate{
+				word:     sibling,
+				steps:    next,
+				estimate: letterDistance(sibling, target),
+			})
+		}
+	}
+
+	return nil, ErrNoRoute
+}
+
+// candidate struct represents a word in the A* frontier, with the number of steps it took to reach
+// it from the origin, and the estimated number of steps left to reach the target
+type candidate struct {
+	word     string
+	steps    int
+	estimate int
+}
+
+// frontier type is a priority queue of candidates, implementing `heap.Interface`. The candidate with the
+// lowest (steps + estimate) sum is the first one to be popped.
+type frontier []*candidate
+
+// Len method returns the number of candidates in the frontier
+func (f frontier) Len() int {
+	return len(f)
+}
+
+// Less method compares two candidates by their total cost, then by their estimate, then alphabetically
+func (f frontier) Less(i, j int) bool {
+	if ci, cj := f[i].steps+f[i].estimate, f[j].steps+f[j].estimate; ci != cj {
+		return ci < cj
+	}
+
+	if f[i].estimate != f[j].estimate {
+		return f[i].estimate < f[j].estimate
+	}
+
+	return f[i].word < f[j].word
+}
+
+// Swap method swaps the candidates in indexes i and j
+func (f frontier) Swap(i, j int) {
+	f[i], f[j] = f[j], f[i]
+}
+
+// Push method adds a new candidate to the frontier
+func (f *frontier) Push(x any) {
+	*f = append(*f, x.(*candidate))
+}
+
+// Pop method removes and returns the last candidate in the frontier
+func (f *frontier) Pop() any {
+	old := *f
+	c := old[len(old)-1]
+	*f = old[:len(old)-1]
+
+	return c
+}
@@ -0,0 +1,264 @@
+package graph
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Pair struct is an origin and a target word to look up a route for, in FindRoutesBatch()
+type Pair struct {
+	Origin string
+	Target string
+}
+
+// BatchResult struct is the outcome of looking up a route for a Pair in FindRoutesBatch(): either the
+// route, or the error the lookup returned (as FindRoute() would)
+type BatchResult struct {
+	Pair  Pair
+	Route []string
+	Err   error
+}
+
+// FindRoutesBatch method will look up the shortest route for each of the input pairs of words, returning one
+// BatchResult per Pair, in the same order as the input.
+//
+// Pairs are grouped by their origin word, and each origin is handled by one of the workers (as set with
+// WithWorkers()), concurrently. For each origin, a single breadth-first search is done, which stops once all the
+// targets paired with it are reached; so the routes from the same origin come out of the same search tree. The
+// siblings of each word are looked up once for the whole batch, and shared between all workers.
+//
+// The routes are the same as the ones from FindRoute() with the BreadthFirst strategy, regardless of
+// WithStrategy(). The Constraints set with WithConstraints() are honored; however, pairs with waypoints are
+// looked up one by one, as their routes cannot share a search tree. A rarity cost (WithRarityCost()) cannot be
+// honored, so all valid pairs hold ErrUnsupported if it is set.
+//
+// Each pair's result holds the error its lookup returned, such as a WordError if either word does not exist in
+// the dictionary, or ErrNoRoute if there is no route between both words. The time limit (WithTimeout()) applies
+// to the whole batch; the pairs which are not done by then hold a TimeoutError. The words in each pair are
+// normalized like in FindRoute(), while the results hold the pairs as they were input.
+func (n *Node) FindRoutesBatch(pairs []Pair, opts ...Option) []BatchResult {
+	cfg := newConfig(opts...)
+	cfg.strategy = BreadthFirst
+
+	if cfg.stats == nil {
+		cfg.stats = &Stats{}
+	}
+
+	cfg = n.normalizeRules(cfg)
+	results := make([]BatchResult, len(pairs))
+	unsupported := cfg.unsupported(cfg.strategy, opFindRoutesBatch)
+
+	// the pairs as looked up, with their words normalized; the results keep the input pairs
+	queries := make([]Pair, len(pairs))
+
+	// group the valid pairs by origin, keeping the order of the origins
+	byOrigin := map[string][]int{}
+	origins := []string{}
+
+	for idx, p := range pairs {
+		results[idx].Pair = p
+
+		origin, target, err := n.validate(p.Origin, p.Target, opFindRoutesBatch)
+
+		if err != nil {
+			results[idx].Err = err
+			continue
+		}
+
+		if unsupported != nil {
+			results[idx].Err = unsupported
+			continue
+		}
+
+		if _, err := n.stops(cfg, origin, target, opFindRoutesBatch); err != nil {
+			results[idx].Err = err
+			continue
+		}
+
+		queries[idx] = Pair{Origin: origin, Target: target}
+
+		if _, ok := byOrigin[origin]; !ok {
+			origins = append(origins, origin)
+		}
+
+		byOrigin[origin] = append(byOrigin[origin], idx)
+	}
+
+	// set the time limit for the batch
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+
+	cache := newNeighborCache(n.getRoot(), cfg)
+	jobs := make(chan string)
+	wg := &sync.WaitGroup{}
+
+	for i := 0; i < cfg.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// each origin is handled by a single worker, so its results are only written by it
+			for origin := range jobs {
+				n.batchOrigin(ctx, cfg, cache, origin, byOrigin[origin], queries, results)
+			}
+		}()
+	}
+
+	for _, origin := range origins {
+		jobs <- origin
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	return results
+}
+
+// batchOrigin method will look up the routes for the results (in the input indexes) sharing the same origin,
+// from a single search tree; where queries holds the (normalized) pairs to look up for each result
+func (n *Node) batchOrigin(
+	ctx context.Context,
+	cfg *config,
+	cache *neighborCache,
+	origin string,
+	indexes []int,
+	queries []Pair,
+	results []BatchResult,
+) {
+	// routes with waypoints are looked up one by one
+	if cfg.rules != nil && len(cfg.rules.waypoints) > 0 {
+		for _, idx := range indexes {
+			c := *cfg
+			c.stats = &Stats{}
+
+			results[idx].Route, results[idx].Err = n.route(ctx, &c, origin, queries[idx].Target)
+			atomic.AddInt64(&cfg.stats.Expanded, c.stats.Expanded)
+		}
+
+		return
+	}
+
+	targets := make(map[string]struct{}, len(indexes))
+	for _, idx := range indexes {
+		targets[queries[idx].Target] = struct{}{}
+	}
+
+	visited, err := n.searchTree(ctx, cfg, cache, origin, targets)
+
+	for _, idx := range indexes {
+		target := queries[idx].Target
+
+		switch _, ok := visited[target]; {
+		case ok:
+			results[idx].Route = backtrack(visited, origin, target)
+		case err != nil:
+			results[idx].Err = &TimeoutError{Err: err}
+		default:
+			results[idx].Err = ErrNoRoute
+		}
+	}
+}
+
+// searchTree method will do a breadth-first search from the origin, like `shortest()`, until all the input targets
+// are reached (or there are no words left to explore). It returns the visited set (word to previous word), from
+// which the route to each reached target can be rebuilt with `backtrack()`.
+//
+// The search halts once the input context is done, returning its error along with the words visited so far.
+func (n *Node) searchTree(
+	ctx context.Context,
+	cfg *config,
+	cache *neighborCache,
+	origin string,
+	targets map[string]struct{},
+) (map[string]string, error) {
+	// initialize the visited set (word to previous word), the depth of each word (its position
+	// in the route), and the queue with the origin
+	visited := map[string]string{origin: ""}
+	depth := map[string]int{origin: 1}
+	queue := []string{origin}
+	pending := len(targets)
+
+	// the stats are shared by all workers
+	var expanded int64
+	defer func() {
+		atomic.AddInt64(&cfg.stats.Expanded, expanded)
+	}()
+
+	for len(queue) > 0 && pending > 0 {
+		// halt the search once the context is done
+		if err := ctx.Err(); err != nil {
+			return visited, err
+		}
+
+		// pop the first word in the queue
+		word := queue[0]
+		queue = queue[1:]
+
+		// its siblings would exceed the maximum depth
+		if cfg.maxDepth > 0 && depth[word] >= cfg.maxDepth {
+			continue
+		}
+
+		expanded++
+
+		for _, sibling := range cache.neighbors(word) {
+			// skip words that were already reached
+			if _, ok := visited[sibling]; ok {
+				continue
+			}
+
+			visited[sibling] = word
+			depth[sibling] = depth[word] + 1
+
+			// first time a target is reached is also the shortest route to it
+			if _, ok := targets[sibling]; ok {
+				pending--
+			}
+
+			queue = append(queue, sibling)
+		}
+	}
+
+	return visited, nil
+}
+
+// neighborCache struct holds the (sorted) siblings of the words looked up during a batch, allowed by its
+// constraints, so that each word's siblings are only looked up once. It is safe for concurrent use.
+type neighborCache struct {
+	mu    sync.RWMutex
+	root  *Node
+	cfg   *config
+	words map[string][]string
+}
+
+// newNeighborCache function will create an empty neighborCache for the input root node and config
+func newNeighborCache(root *Node, cfg *config) *neighborCache {
+	return &neighborCache{
+		root:  root,
+		cfg:   cfg,
+		words: map[string][]string{},
+	}
+}
+
+// neighbors method will return the siblings of the input word, looking them up if they are not cached yet.
+// A word without siblings (a dead-end) has none.
+func (c *neighborCache) neighbors(word string) []string {
+	c.mu.RLock()
+	siblings, ok := c.words[word]
+	c.mu.RUnlock()
+
+	if ok {
+		return siblings
+	}
+
+	// errors only mean that the word is a dead-end
+	siblings, _ = c.root.allowedNeighbors(c.cfg, word)
+
+	c.mu.Lock()
+	c.words[word] = siblings
+	c.mu.Unlock()
+
+	return siblings
+}
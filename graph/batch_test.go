@@ -0,0 +1,194 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFindRoutesBatch(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoutesBatch()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		pairs []Pair
+		opts  []Option
+		wants []BatchResult
+	}
+
+	root := New()
+	root.Add(combinations("abcd", 3)...)
+	root.Add("zzzz")
+
+	var tests = []test{
+		{
+			name: "pairs sharing origins",
+			pairs: []Pair{
+				{Origin: "aaa", Target: "ddd"},
+				{Origin: "bbb", Target: "abc"},
+				{Origin: "aaa", Target: "abc"},
+				{Origin: "aaa", Target: "ddd"},
+			},
+			wants: []BatchResult{
+				{Pair: Pair{Origin: "aaa", Target: "ddd"}, Route: []string{"aaa", "aad", "add", "ddd"}},
+				{Pair: Pair{Origin: "bbb", Target: "abc"}, Route: []string{"bbb", "abb", "abc"}},
+				{Pair: Pair{Origin: "aaa", Target: "abc"}, Route: []string{"aaa", "aac", "abc"}},
+				{Pair: Pair{Origin: "aaa", Target: "ddd"}, Route: []string{"aaa", "aad", "add", "ddd"}},
+			},
+		},
+		{
+			name: "per-pair errors",
+			pairs: []Pair{
+				{Origin: "aaa", Target: "zzzz"},
+				{Origin: "aaa", Target: "aaa"},
+				{Origin: "aaa", Target: "eee"},
+				{Origin: "aaa", Target: "aab"},
+			},
+			wants: []BatchResult{
+				{Pair: Pair{Origin: "aaa", Target: "zzzz"}, Err: ErrNoRoute},
+				{Pair: Pair{Origin: "aaa", Target: "aaa"}, Err: ErrSameWord},
+				{Pair: Pair{Origin: "aaa", Target: "eee"}, Err: ErrNonExistent},
+				{Pair: Pair{Origin: "aaa", Target: "aab"}, Route: []string{"aaa", "aab"}},
+			},
+		},
+		{
+			name: "maximum depth",
+			pairs: []Pair{
+				{Origin: "aaa", Target: "ddd"},
+				{Origin: "aaa", Target: "add"},
+			},
+			opts: []Option{WithMaxDepth(3)},
+			wants: []BatchResult{
+				{Pair: Pair{Origin: "aaa", Target: "ddd"}, Err: ErrNoRoute},
+				{Pair: Pair{Origin: "aaa", Target: "add"}, Route: []string{"aaa", "aad", "add"}},
+			},
+		},
+		{
+			name: "constraints",
+			pairs: []Pair{
+				{Origin: "aaa", Target: "add"},
+				{Origin: "aaa", Target: "aad"},
+			},
+			opts: []Option{WithConstraints(Constraints{Forbidden: []string{"aad"}})},
+			wants: []BatchResult{
+				{Pair: Pair{Origin: "aaa", Target: "add"}, Route: []string{"aaa", "ada", "add"}},
+				{Pair: Pair{Origin: "aaa", Target: "aad"}, Err: ErrNoRoute},
+			},
+		},
+		{
+			name: "waypoints",
+			pairs: []Pair{
+				{Origin: "aaa", Target: "add"},
+				{Origin: "bbb", Target: "add"},
+			},
+			opts: []Option{WithConstraints(Constraints{Waypoints: []string{"ccc"}})},
+			wants: []BatchResult{
+				{Pair: Pair{Origin: "aaa", Target: "add"}, Route: []string{"aaa", "aac", "acc", "ccc", "ccd", "acd", "add"}},
+				{Pair: Pair{Origin: "bbb", Target: "add"}, Route: []string{"bbb", "bbc", "bcc", "ccc", "acc", "acd", "add"}},
+			},
+		},
+		{
+			name:  "no pairs",
+			wants: []BatchResult{},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		results := root.FindRoutesBatch(test.pairs, test.opts...)
+
+		if len(results) != len(test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output length mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				len(test.wants),
+				len(results),
+				test.name,
+			)
+			return
+		}
+
+		for i, res := range results {
+			wants := test.wants[i]
+
+			if res.Pair != wants.Pair || !errors.Is(res.Err, wants.Err) || !reflect.DeepEqual(res.Route, wants.Route) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] output mismatch error on pair #%v: wanted %+v ; got %+v -- action: %s",
+					idx,
+					module,
+					funcname,
+					i,
+					wants,
+					res,
+					test.name,
+				)
+				continue
+			}
+
+			// routes are the same as the ones from FindRoute() with the BreadthFirst strategy
+			if res.Err != nil {
+				continue
+			}
+
+			route, err := root.FindRoute(res.Pair.Origin, res.Pair.Target, append(test.opts, WithStrategy(BreadthFirst))...)
+
+			if err != nil || !reflect.DeepEqual(route, res.Route) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] breadth-first mismatch error on pair #%v: wanted %v ; got %v (%v) -- action: %s",
+					idx,
+					module,
+					funcname,
+					i,
+					res.Route,
+					route,
+					err,
+					test.name,
+				)
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func BenchmarkFindRoutesBatch(b *testing.B) {
+	root := New()
+	root.Add(combinations("abcdef", 4)...)
+
+	// a few origins paired with many targets
+	words := combinations("abcdef", 4)
+	pairs := make([]Pair, 0, len(words)/16*4)
+
+	for _, origin := range []string{"aaaa", "bcde", "ffff", "face"} {
+		for i := 0; i < len(words); i += 16 {
+			if words[i] != origin {
+				pairs = append(pairs, Pair{Origin: origin, Target: words[i]})
+			}
+		}
+	}
+
+	b.Run("Batch", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			root.FindRoutesBatch(pairs)
+		}
+	})
+
+	b.Run("Loop", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			for _, p := range pairs {
+				_, _ = root.FindRoute(p.Origin, p.Target, WithStrategy(BreadthFirst))
+			}
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+)
+
+// breadthFirst method will look up the shortest route from the origin to the target word, by exploring
+// the graph one level at a time (all words one step away from the origin, then all words two steps away,
+// and so on).
+//
+// It uses `Fuzz()` as the neighbor function, and keeps a shared set of visited words (mapped to the word
+// they were reached from), so that each word is only explored once. As the first time the target is seen
+// is also the closest it can be from the origin, the returned route is guaranteed to be the shortest one.
+//
+// The siblings of each word are sorted before being queued, so the same query against the same dictionary
+// will always return the same route. The query halts once the input context is done, returning its error.
+//
+// If a maximum depth is configured, words are not explored past it.
+func (n *Node) breadthFirst(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	return n.shortest(ctx, cfg, origin, target, nil)
+}
+
+// shortest method is the breadth-first search behind `breadthFirst()`, which will also skip any step (from one
+// word to its sibling) for which the input skip function returns true. A nil skip function skips nothing.
+func (n *Node) shortest(
+	ctx context.Context,
+	cfg *config,
+	origin, target string,
+	skip func(from, to string) bool,
+) ([]string, error) {
+	// work on the root for a complete word look-up
+	node := n.getRoot()
+
+	// initialize the visited set (word to previous word), the depth of each word (its position
+	// in the route), and the queue with the origin
+	visited := map[string]string{origin: ""}
+	depth := map[string]int{origin: 1}
+	queue := []string{origin}
+
+	for len(queue) > 0 {
+		// halt the query once the context is done
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// pop the first word in the queue
+		word := queue[0]
+		queue = queue[1:]
+
+		// its siblings would exceed the maximum depth
+		if cfg.maxDepth > 0 && depth[word] >= cfg.maxDepth {
+			continue
+		}
+
+		cfg.stats.Expanded++
+		siblings, err := node.allowedNeighbors(cfg, word)
+
+		// a word without siblings is a dead-end
+		if err != nil {
+			continue
+		}
+
+		for _, sibling := range siblings {
+			// skip words that were already reached
+			if _, ok := visited[sibling]; ok {
+				continue
+			}
+
+			// skip steps which are ruled out by the caller
+			if skip != nil && skip(word, sibling) {
+				continue
+			}
+
+			visited[sibling] = word
+			depth[sibling] = depth[word] + 1
+
+			// first time the target is reached is also the shortest route to it
+			if sibling == target {
+				return backtrack(visited, origin, target), nil
+			}
+
+			queue = append(queue, sibling)
+		}
+	}
+
+	return nil, ErrNoRoute
+}
+
+// neighbors method will return the siblings of the input word (the same ones as `Fuzz()`), sorted alphabetically,
+// as read from the word-neighbor graph.
+//
+// The sorted order ensures that the traversals relying on this method are deterministic.
+func (n *Node) neighbors(word string) ([]string, error) {
+	siblings := n.adjacency().neighbors(word)
+
+	if len(siblings) == 0 {
+		return nil, ErrNoRoute
+	}
+
+	return siblings, nil
+}
+
+// backtrack function will rebuild a route from the origin to the target, from a map of words to the
+// word they were reached from.
+func backtrack(visited map[string]string, origin, target string) []string {
+	route := []string{target}
+
+	for word := target; word != origin; {
+		word = visited[word]
+		route = append(route, word)
+	}
+
+	// reverse the route, as it was built from the target back to the origin
+	for i, j := 0, len(route)-1; i < j; i, j = i+1, j-1 {
+		route[i], route[j] = route[j], route[i]
+	}
+
+	return route
+}
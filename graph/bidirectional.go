@@ -0,0 +1,136 @@
+package graph
+
+import (
+	"context"
+)
+
+// bidirectional method will look up the shortest route from the origin to the target word, by growing
+// two breadth-first frontiers at once: one from the origin and one from the target.
+//
+// As the sibling relationship from `Fuzz()` is symmetric (if "cat" is a sibling of "cot", then "cot" is
+// a sibling of "cat"), the frontier grown from the target uses the same neighbor function. On each iteration,
+// the smallest frontier is expanded by one level; once a word is reached by both frontiers, the routes
+// from each side are joined at that word.
+//
+// This keeps each frontier at roughly half the depth of a plain breadth-first search, which matters for
+// longer words where the number of words explored grows very quickly with each level. The query halts once
+// the input context is done, returning its error.
+//
+// If a maximum depth is configured, the search halts once the frontiers are too far apart to be joined
+// within it.
+func (n *Node) bidirectional(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	// work on the root for a complete word look-up
+	node := n.getRoot()
+
+	// initialize a visited set (word to previous word) and a queue for each direction
+	forward := map[string]string{origin: ""}
+	backward := map[string]string{target: ""}
+	fQueue := []string{origin}
+	bQueue := []string{target}
+
+	// keep track of the number of levels expanded; a route found on the next level would have
+	// (levels + 2) words
+	var levels int
+
+	for len(fQueue) > 0 && len(bQueue) > 0 {
+		var meets []string
+		var err error
+
+		// any route found from here on would exceed the maximum depth
+		if cfg.maxDepth > 0 && levels+2 > cfg.maxDepth {
+			break
+		}
+		levels++
+
+		// expand the smallest frontier
+		if len(fQueue) <= len(bQueue) {
+			fQueue, meets, err = node.expandLevel(ctx, cfg, fQueue, forward, backward)
+		} else {
+			bQueue, meets, err = node.expandLevel(ctx, cfg, bQueue, backward, forward)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(meets) == 0 {
+			continue
+		}
+
+		// out of all the words where both frontiers met on this level, keep the shortest
+		// route (or the first one found, on a tie)
+		var route []string
+		for _, meet := range meets {
+			r := join(forward, backward, origin, target, meet)
+
+			if route == nil || len(r) < len(route) {
+				route = r
+			}
+		}
+
+		return route, nil
+	}
+
+	return nil, ErrNoRoute
+}
+
+// expandLevel method will explore the siblings of all words in the input queue, registering them in
+// the visited map (if allowed by the configured constraints). It returns the queue for the next level, and the
+// list of words which are also present in the other direction's visited map (where both frontiers meet). It
+// returns the context's error once it is done.
+func (n *Node) expandLevel(
+	ctx context.Context,
+	cfg *config,
+	queue []string,
+	visited, other map[string]string,
+) ([]string, []string, error) {
+	var next []string
+	var meets []string
+
+	for _, word := range queue {
+		// halt the query once the context is done
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		cfg.stats.Expanded++
+		siblings, err := n.allowedNeighbors(cfg, word)
+
+		// a word without siblings is a dead-end
+		if err != nil {
+			continue
+		}
+
+		for _, sibling := range siblings {
+			// skip words that were already reached from this direction
+			if _, ok := visited[sibling]; ok {
+				continue
+			}
+			visited[sibling] = word
+
+			// take note if this word was already reached from the other direction
+			if _, ok := other[sibling]; ok {
+				meets = append(meets, sibling)
+			}
+
+			next = append(next, sibling)
+		}
+	}
+
+	return next, meets, nil
+}
+
+// join function will build a complete route from the origin to the target, out of the forward and
+// backward visited maps, and the word where both searches met.
+func join(forward, backward map[string]string, origin, target, meet string) []string {
+	// build the route from the origin up to the meeting word
+	route := backtrack(forward, origin, meet)
+
+	// then follow the backward map from the meeting word to the target
+	for word := meet; word != target; {
+		word = backward[word]
+		route = append(route, word)
+	}
+
+	return route
+}
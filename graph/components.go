@@ -0,0 +1,346 @@
+package graph
+
+// components struct is the connected-component index of the dictionary, over the sibling relationship from
+// `Fuzz()`: two words are in the same component if there is a route from one to the other. It is kept up to date
+// with the word-neighbor graph it is built on, as words are added or removed (see `insert()` and `remove()`).
+//
+// When built, components are numbered from 0, in the (alphabetical) order of their first word. Components which
+// come up afterwards (by splitting or adding words) take new IDs, while the IDs of merged or emptied components are
+// no longer used.
+type components struct {
+	adj   *adjacency // word-neighbor graph the index is built on
+	ids   []int32    // word ID to the ID of its component; -1 for the IDs of removed words
+	sizes []int      // number of words in each component, by ID
+}
+
+// newComponents function will build the connected-component index for the input word-neighbor graph, by joining
+// each word with its siblings in a union-find (disjoint-set) structure
+func newComponents(a *adjacency) *components {
+	words := a.words
+
+	// each word starts in its own set, and is joined with the sets of its siblings
+	sets := newUnionFind(len(words))
+
+	for idx := range words {
+		for _, sibling := range a.lists[idx] {
+			sets.union(idx, int(sibling))
+		}
+	}
+
+	// number the components in the order of their first word
+	c := &components{
+		adj: a,
+		ids: make([]int32, len(words)),
+	}
+	compIDs := map[int]int32{}
+
+	for idx, w := range words {
+		// skip the IDs of removed words
+		if _, ok := a.ids[w]; !ok {
+			c.ids[idx] = -1
+			continue
+		}
+
+		set := sets.find(idx)
+
+		id, ok := compIDs[set]
+		if !ok {
+			id = int32(len(c.sizes))
+			compIDs[set] = id
+			c.sizes = append(c.sizes, 0)
+		}
+
+		c.ids[idx] = id
+		c.sizes[id]++
+	}
+
+	return c
+}
+
+// of method will return the ID of the component of the input word, or false if it does not exist in the dictionary
+func (c *components) of(word string) (int32, bool) {
+	id, ok := c.adj.ids[word]
+
+	if !ok {
+		return -1, false
+	}
+
+	return c.ids[id], true
+}
+
+// reachable method will return true if both words are in the same component, or false if either of them does not
+// exist in the dictionary
+func (c *components) reachable(a, b string) bool {
+	idA, okA := c.of(a)
+	idB, okB := c.of(b)
+
+	return okA && okB && idA == idB
+}
+
+// insert method will add the word with the input ID (just added to the word-neighbor graph) to the index: in a
+// new component if it has no siblings, or in the same component as them; merging their components if they were
+// apart. The words of the smaller components are moved to the largest one.
+func (c *components) insert(id int32) {
+	for int(id) >= len(c.ids) {
+		c.ids = append(c.ids, -1)
+	}
+
+	// pick the largest component among the siblings'
+	keep := int32(-1)
+	for _, sid := range c.adj.lists[id] {
+		if comp := c.ids[sid]; keep < 0 || c.sizes[comp] > c.sizes[keep] {
+			keep = comp
+		}
+	}
+
+	if keep < 0 {
+		c.ids[id] = int32(len(c.sizes))
+		c.sizes = append(c.sizes, 1)
+
+		return
+	}
+
+	c.ids[id] = keep
+	c.sizes[keep]++
+
+	// move the words in the other components to the one kept
+	for _, sid := range c.adj.lists[id] {
+		if comp := c.ids[sid]; comp != keep {
+			c.sizes[keep] += c.relabel(sid, comp, keep)
+			c.sizes[comp] = 0
+		}
+	}
+}
+
+// remove method will take the word with the input ID out of the index, once it is removed from the word-neighbor
+// graph, along with the IDs of its former siblings.
+//
+// Removing a word may split its component. A search is started from each sibling at once, always expanding the
+// one which visited the fewest words so far; searches which meet are merged into one. A search which runs out of
+// words to visit before meeting the others is a split-off piece of the component, which takes a new ID (relabeling
+// only the words it visited). Once a single search is left, its words keep the component's ID; so the cost of
+// the removal is bound by the size of the smaller pieces, and not by the size of the component.
+func (c *components) remove(id int32, siblings []int32) {
+	comp := c.ids[id]
+	c.ids[id] = -1
+	c.sizes[comp]--
+
+	if len(siblings) < 2 {
+		return
+	}
+
+	// each search is identified by the index of the sibling it started from, and merged searches share the
+	// same root in the union-find
+	sets := newUnionFind(len(siblings))
+	owner := make(map[int32]int, len(siblings))
+	queues := make([][]int32, len(siblings))
+	members := make([][]int32, len(siblings))
+	active := make([]bool, len(siblings))
+	left := len(siblings)
+
+	for idx, sid := range siblings {
+		owner[sid] = idx
+		queues[idx] = []int32{sid}
+		members[idx] = []int32{sid}
+		active[idx] = true
+	}
+
+	for left > 1 {
+		// expand the search which visited the fewest words so far
+		cur := -1
+		for idx := range siblings {
+			if active[idx] && (cur < 0 || len(members[idx]) < len(members[cur])) {
+				cur = idx
+			}
+		}
+
+		// the search ran out of words before meeting the others, so its words split into a new component
+		if len(queues[cur]) == 0 {
+			split := int32(len(c.sizes))
+			c.sizes = append(c.sizes, len(members[cur]))
+			c.sizes[comp] -= len(members[cur])
+
+			for _, w := range members[cur] {
+				c.ids[w] = split
+			}
+
+			active[cur] = false
+			left--
+
+			continue
+		}
+
+		word := queues[cur][0]
+		queues[cur] = queues[cur][1:]
+
+		for _, next := range c.adj.lists[word] {
+			idx, ok := owner[next]
+
+			if !ok {
+				owner[next] = cur
+				queues[cur] = append(queues[cur], next)
+				members[cur] = append(members[cur], next)
+
+				continue
+			}
+
+			// merge the search which visited this word into the current one
+			if other := sets.find(idx); other != cur {
+				sets.union(cur, other)
+				root, merged := cur, other
+				if sets.find(cur) != cur {
+					root, merged = other, cur
+				}
+
+				// append the smaller slices to the larger ones
+				if len(queues[root]) < len(queues[merged]) {
+					queues[root], queues[merged] = queues[merged], queues[root]
+				}
+				if len(members[root]) < len(members[merged]) {
+					members[root], members[merged] = members[merged], members[root]
+				}
+
+				queues[root] = append(queues[root], queues[merged]...)
+				members[root] = append(members[root], members[merged]...)
+				queues[merged], members[merged] = nil, nil
+				active[merged] = false
+				left--
+
+				cur = root
+			}
+		}
+	}
+}
+
+// relabel method will move all the words in component from which are reachable from the word with the input ID to
+// component to, returning the number of words moved
+func (c *components) relabel(id, from, to int32) int {
+	c.ids[id] = to
+	queue := []int32{id}
+	moved := 1
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, next := range c.adj.lists[cur] {
+			if c.ids[next] != from {
+				continue
+			}
+
+			c.ids[next] = to
+			moved++
+
+			queue = append(queue, next)
+		}
+	}
+
+	return moved
+}
+
+// components method will return the connected-component index of the dictionary, building it if it is not
+// built yet
+func (n *Node) components() *components {
+	root := n.getRoot()
+	adj := root.adjacency()
+	idx := root.state().index
+
+	// a root node not created with New() has nowhere to keep the index
+	if idx == nil {
+		return newComponents(adj)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.components == nil {
+		idx.components = newComponents(adj)
+	}
+
+	return idx.components
+}
+
+// ComponentOf method will return the ID of the connected component the word belongs to, or -1 if it does not
+// exist in the dictionary.
+//
+// Two words in the same component are connected by a route; while there is no route between words in different
+// components. Components are numbered from 0, in the alphabetical order of their first word. As words are added or
+// removed, the IDs of the components which are not affected stay the same, while split components take new IDs.
+//
+// The first call to this method (and to Reachable(), ComponentSize() or any route query) builds the component
+// index for the whole dictionary, which is then kept up to date as words are added to (or removed from) it.
+func (n *Node) ComponentOf(word string) int {
+	word, ok := n.normalize(word)
+
+	if !ok {
+		return -1
+	}
+
+	id, ok := n.components().of(word)
+
+	if !ok {
+		return -1
+	}
+
+	return int(id)
+}
+
+// ComponentSize method will return the number of words in the connected component with the input ID, as
+// returned by ComponentOf(); or zero if there is no such component.
+func (n *Node) ComponentSize(id int) int {
+	sizes := n.components().sizes
+
+	if id < 0 || id >= len(sizes) {
+		return 0
+	}
+
+	return sizes[id]
+}
+
+// Reachable method will return true if there is a route between both words (or if they are the same word),
+// which means they are in the same connected component. It returns false if either word does not exist in the
+// dictionary. Both words are normalized with the dictionary's Normalizer, like in Find().
+func (n *Node) Reachable(a, b string) bool {
+	a, okA := n.normalize(a)
+	b, okB := n.normalize(b)
+
+	return okA && okB && n.components().reachable(a, b)
+}
+
+// unionFind type is a disjoint-set structure over the integers from 0 to its length, where each element points
+// to its parent in the set (and the root of a set points to itself)
+type unionFind []int
+
+// newUnionFind function will create a unionFind with size elements, each one in its own set
+func newUnionFind(size int) unionFind {
+	u := make(unionFind, size)
+
+	for i := range u {
+		u[i] = i
+	}
+
+	return u
+}
+
+// find method will return the root of the set the element belongs to, compressing the path to it
+func (u unionFind) find(i int) int {
+	for u[i] != i {
+		u[i] = u[u[i]]
+		i = u[i]
+	}
+
+	return i
+}
+
+// union method will join the sets of both elements; the root with the lowest value becomes the root of the
+// joined set
+func (u unionFind) union(a, b int) {
+	ra, rb := u.find(a), u.find(b)
+
+	switch {
+	case ra < rb:
+		u[rb] = ra
+	case rb < ra:
+		u[ra] = rb
+	}
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestComponents(t *testing.T) {
+	module := "Components"
+	funcname := "ComponentOf() / ComponentSize() / Reachable()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name      string
+		a         string
+		b         string
+		reachable bool
+		component int
+		size      int
+	}
+
+	// three components: {bat, cat, cats, cot}, {dig, dog, fog} and {zebra}
+	root := New()
+	root.Add("cat", "cot", "bat", "cats", "dog", "dig", "fog", "zebra")
+
+	var tests = []test{
+		{
+			name:      "same component",
+			a:         "bat",
+			b:         "cats",
+			reachable: true,
+			component: 0,
+			size:      4,
+		},
+		{
+			name:      "same component -- other way around",
+			a:         "cats",
+			b:         "bat",
+			reachable: true,
+			component: 0,
+			size:      4,
+		},
+		{
+			name:      "different components",
+			a:         "dog",
+			b:         "cat",
+			component: 1,
+			size:      3,
+		},
+		{
+			name:      "isolated word",
+			a:         "zebra",
+			b:         "zebra",
+			reachable: true,
+			component: 2,
+			size:      1,
+		},
+		{
+			name:      "non-existing word",
+			a:         "cog",
+			b:         "cat",
+			component: -1,
+			size:      0,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		if reachable := root.Reachable(test.a, test.b); reachable != test.reachable {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] reachability mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.reachable,
+				reachable,
+				test.name,
+			)
+			return
+		}
+
+		id := root.ComponentOf(test.a)
+
+		if id != test.component {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] component mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.component,
+				id,
+				test.name,
+			)
+			return
+		}
+
+		if size := root.ComponentSize(id); size != test.size {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] component size mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.size,
+				size,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestComponentsAdd(t *testing.T) {
+	module := "Components"
+	funcname := "Add()"
+
+	_ = module
+	_ = funcname
+
+	root := New()
+	root.Add("cat", "cot", "dog", "dig")
+
+	if root.Reachable("cat", "dog") {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] words should not be reachable before being connected -- action: %s",
+			0,
+			module,
+			funcname,
+			"before adding a bridge",
+		)
+		return
+	}
+
+	// "cog" connects both components
+	root.Add("cog")
+
+	if !root.Reachable("cat", "dog") || root.ComponentSize(root.ComponentOf("cog")) != 5 {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] stale component index after adding words -- action: %s",
+			1,
+			module,
+			funcname,
+			"after adding a bridge",
+		)
+		return
+	}
+
+	route, err := root.FindRoute("cat", "dog", WithStrategy(BreadthFirst))
+
+	if err != nil || !isValidRoute(root, route, "cat", "dog") {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] unexpected route after adding words: %v (%v) -- action: %s",
+			2,
+			module,
+			funcname,
+			route,
+			err,
+			"route after adding a bridge",
+		)
+		return
+	}
+}
+
+func TestFindRouteComponents(t *testing.T) {
+	module := "Components"
+	funcname := "FindRoute()"
+
+	_ = module
+	_ = funcname
+
+	// a dense dictionary would take a while to exhaust with the Weighted strategy, while the
+	// disconnected word is told apart right away
+	root := New()
+	root.Add(combinations("abcdefgh", 4)...)
+	root.Add("zzzzz")
+
+	// build the index beforehand
+	_ = root.ComponentOf("aaaa")
+
+	for idx, strategy := range []Strategy{Weighted, BreadthFirst, Bidirectional, AStar, Dijkstra} {
+		stats := &Stats{}
+		_, err := root.FindRoute("aaaa", "zzzzz", WithStrategy(strategy), WithStats(stats))
+
+		if err != ErrNoRoute || stats.Expanded != 0 {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected outcome: wanted %v with no expanded words ; got %v with %v -- action: strategy %v",
+				idx,
+				module,
+				funcname,
+				ErrNoRoute,
+				err,
+				stats.Expanded,
+				strategy,
+			)
+		}
+	}
+}
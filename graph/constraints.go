@@ -0,0 +1,190 @@
+package graph
+
+import "unicode/utf8"
+
+// Constraints struct holds the rules a route must follow, on top of the single-character changes between
+// words. It is set on a route query with WithConstraints(), and it is honored by all strategies while
+// exploring the dictionary (the words and changes it rules out are never explored).
+//
+// If the constraints make the target unreachable, the route query returns ErrNoRoute.
+type Constraints struct {
+	// Forbidden words cannot show up in the route, such as a blocklist of profanity or proper nouns.
+	// A forbidden origin, target or waypoint means there is no route.
+	Forbidden []string
+
+	// Waypoints are words the route must go through, in this order, between the origin and the target.
+	// Each waypoint must exist in the dictionary, and cannot repeat the origin, the target or another waypoint.
+	// Each leg of the route (up to a waypoint, or from the last one to the target) avoids the words used in
+	// the previous legs, keeping the route loop-free; unless that rules out all routes, in which case each leg
+	// is looked up on its own, so a word may show up in more than one leg.
+	Waypoints []string
+
+	// FixedLength rules out the changes that add or remove characters, so that all words in the route have
+	// the same length (the classic word ladder rule). The origin, target and waypoints must have the same
+	// length too.
+	FixedLength bool
+}
+
+// rules struct is the compiled form of the Constraints set on a route query
+type rules struct {
+	blocked     map[string]struct{}
+	waypoints   []string
+	fixedLength bool
+	normalized  bool // set once the words are normalized for the dictionary (see `normalizeRules()`)
+}
+
+// WithConstraints function will set the Constraints the route must follow, such as words it must avoid or go
+// through, or keeping all words in the route at the same length. By default, routes are unconstrained.
+func WithConstraints(constraints Constraints) Option {
+	return func(c *config) {
+		r := &rules{
+			blocked:     make(map[string]struct{}, len(constraints.Forbidden)),
+			waypoints:   append([]string{}, constraints.Waypoints...),
+			fixedLength: constraints.FixedLength,
+		}
+
+		for _, w := range constraints.Forbidden {
+			r.blocked[w] = struct{}{}
+		}
+
+		c.rules = r
+	}
+}
+
+// forLeg method will return a copy of the config to look up a leg of a route with waypoints, which is not a
+// complete route by itself (so it is not reported to the onRoute callback)
+func (c *config) forLeg() *config {
+	cp := *c
+	cp.onRoute = nil
+
+	return &cp
+}
+
+// withBlocked method will return a copy of the config (like `forLeg()`), where the input words are blocked (along
+// with the forbidden ones). It is used to look up each leg of a route with waypoints, without going through the
+// words used in other legs.
+func (c *config) withBlocked(words ...string) *config {
+	cp := c.forLeg()
+	r := &rules{
+		blocked: make(map[string]struct{}, len(words)),
+	}
+
+	if c.rules != nil {
+		r.fixedLength = c.rules.fixedLength
+		r.normalized = c.rules.normalized
+
+		for w := range c.rules.blocked {
+			r.blocked[w] = struct{}{}
+		}
+	}
+
+	for _, w := range words {
+		r.blocked[w] = struct{}{}
+	}
+
+	cp.rules = r
+
+	return cp
+}
+
+// allowed method will return true if the route can take a step from the word to its sibling, according to the
+// configured constraints
+func (c *config) allowed(word, sibling string) bool {
+	if c.rules == nil {
+		return true
+	}
+
+	if c.rules.fixedLength && utf8.RuneCountInString(word) != utf8.RuneCountInString(sibling) {
+		return false
+	}
+
+	_, ok := c.rules.blocked[sibling]
+	return !ok
+}
+
+// allowedResults method will filter the input Results (the weighted siblings of a word) to the ones allowed by
+// the configured constraints
+func (c *config) allowedResults(word string, r []*Result) []*Result {
+	if c.rules == nil {
+		return r
+	}
+
+	out := make([]*Result, 0, len(r))
+
+	for _, res := range r {
+		if c.allowed(word, res.word) {
+			out = append(out, res)
+		}
+	}
+
+	return out
+}
+
+// allowedNeighbors method will return the (sorted) siblings of the input word which are allowed by the
+// configured constraints, as retrieved by `neighbors()`
+func (n *Node) allowedNeighbors(cfg *config, word string) ([]string, error) {
+	siblings, err := n.neighbors(word)
+
+	if err != nil || cfg.rules == nil {
+		return siblings, err
+	}
+
+	out := make([]string, 0, len(siblings))
+
+	for _, s := range siblings {
+		if cfg.allowed(word, s) {
+			out = append(out, s)
+		}
+	}
+
+	return out, nil
+}
+
+// stops method will return the words a route must go through: the origin, the configured waypoints and the
+// target, in this order.
+//
+// It returns a WordError (for the input operation) if a waypoint does not exist in the dictionary, or ErrNoRoute
+// if the constraints rule out any of these words, or if any two consecutive stops are not connected (as told by
+// the component index, before any route is explored).
+func (n *Node) stops(cfg *config, origin, target, op string) ([]string, error) {
+	stops := []string{origin, target}
+
+	if cfg.rules != nil {
+		stops = make([]string, 0, len(cfg.rules.waypoints)+2)
+		stops = append(stops, origin)
+		stops = append(stops, cfg.rules.waypoints...)
+		stops = append(stops, target)
+
+		for _, w := range cfg.rules.waypoints {
+			if !n.has(w) {
+				return nil, n.newWordError(w, RoleWaypoint, op)
+			}
+		}
+
+		seen := make(map[string]struct{}, len(stops))
+
+		for _, w := range stops {
+			// a repeated stop would make the route loop
+			if _, ok := seen[w]; ok {
+				return nil, ErrNoRoute
+			}
+			seen[w] = struct{}{}
+
+			if _, ok := cfg.rules.blocked[w]; ok {
+				return nil, ErrNoRoute
+			}
+
+			if cfg.rules.fixedLength && utf8.RuneCountInString(w) != utf8.RuneCountInString(origin) {
+				return nil, ErrNoRoute
+			}
+		}
+	}
+
+	for i := 1; i < len(stops); i++ {
+		if !n.components().reachable(stops[i-1], stops[i]) {
+			return nil, ErrNoRoute
+		}
+	}
+
+	return stops, nil
+}
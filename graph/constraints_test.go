@@ -0,0 +1,305 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFindRouteConstraints(t *testing.T) {
+	module := "Constraints"
+	funcname := "FindRoute(WithConstraints())"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name        string
+		origin      string
+		target      string
+		constraints Constraints
+		wants       []string
+		err         error
+	}
+
+	// the shortest route from "ab" to "xy" changes the length of the words (ab, a, x, xy), while the only
+	// route keeping the same length is much longer (ab, qb, qc, pc, pd, xd, xy)
+	root := New()
+	root.Add("a", "x", "ab", "xy", "qb", "qc", "pc", "pd", "xd")
+
+	var tests = []test{
+		{
+			name:   "no constraints",
+			origin: "ab",
+			target: "xy",
+			wants:  []string{"ab", "a", "x", "xy"},
+		},
+		{
+			name:        "forbidden words are avoided",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Forbidden: []string{"a"}},
+			wants:       []string{"ab", "qb", "qc", "pc", "pd", "xd", "xy"},
+		},
+		{
+			name:        "fixed length",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{FixedLength: true},
+			wants:       []string{"ab", "qb", "qc", "pc", "pd", "xd", "xy"},
+		},
+		{
+			name:        "waypoint",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Waypoints: []string{"pc"}},
+			wants:       []string{"ab", "qb", "qc", "pc", "pd", "xd", "xy"},
+		},
+		{
+			name:        "waypoints in order",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Waypoints: []string{"qb", "x"}},
+			wants:       []string{"ab", "qb", "qc", "pc", "pd", "xd", "x", "xy"},
+		},
+		{
+			name:        "forbidden words cut off all routes",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Forbidden: []string{"a", "pc"}},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "fixed length with forbidden words",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Forbidden: []string{"pd"}, FixedLength: true},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "forbidden target",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Forbidden: []string{"xy"}},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "forbidden waypoint",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Forbidden: []string{"pc"}, Waypoints: []string{"pc"}},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "fixed length with a target of a different length",
+			origin:      "ab",
+			target:      "x",
+			constraints: Constraints{FixedLength: true},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "waypoints requiring a loop, beyond the maximum depth",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Waypoints: []string{"x", "qc"}},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "repeated waypoint",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Waypoints: []string{"ab"}},
+			err:         ErrNoRoute,
+		},
+		{
+			name:        "non-existing waypoint",
+			origin:      "ab",
+			target:      "xy",
+			constraints: Constraints{Waypoints: []string{"xz"}},
+			err: &WordError{
+				Word:        "xz",
+				Role:        RoleWaypoint,
+				Op:          opFindRoute,
+				Suggestions: []string{"x", "xd", "xy"},
+			},
+		},
+	}
+
+	strategies := []Strategy{Weighted, BreadthFirst, Bidirectional, AStar}
+
+	var verify = func(idx int, test test, strategy Strategy) {
+		route, err := root.FindRoute(
+			test.origin,
+			test.target,
+			WithStrategy(strategy),
+			WithMaxDepth(10),
+			WithConstraints(test.constraints),
+		)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) && !sameError(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s (strategy %v)",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+					strategy,
+				)
+			}
+			return
+		}
+
+		// the Weighted strategy is not deterministic, so its routes are only checked against the constraints
+		if strategy == Weighted {
+			if !isValidRoute(root, route, test.origin, test.target) || !followsConstraints(route, test.constraints) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] invalid route: got %v -- action: %s (strategy %v)",
+					idx,
+					module,
+					funcname,
+					route,
+					test.name,
+					strategy,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s (strategy %v)",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+				strategy,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		for _, strategy := range strategies {
+			verify(idx, test, strategy)
+		}
+	}
+}
+
+func TestFindRouteWaypoints(t *testing.T) {
+	module := "Constraints"
+	funcname := "FindRoute(WithConstraints())"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		maxDepth int
+		wants    int
+		err      error
+	}
+
+	// the shortest route from "abd" to "aec" goes through "abc", which is also the only way to "fbc"; so the
+	// legs of the route through "aec" either share "abc", or the first leg avoids it (abd, aed, aec, abc, fbc)
+	root := New()
+	root.Add("abd", "abc", "aed", "aec", "fbc")
+
+	var tests = []test{
+		{
+			name:  "legs which cannot be loop-free on their own",
+			wants: 5,
+		},
+		{
+			name:     "maximum depth fits the route",
+			maxDepth: 5,
+			wants:    5,
+		},
+		{
+			name:     "maximum depth below the route",
+			maxDepth: 4,
+			err:      ErrNoRoute,
+		},
+	}
+
+	strategies := []Strategy{Weighted, BreadthFirst, Bidirectional, AStar, Dijkstra}
+
+	var verify = func(idx int, test test, strategy Strategy) {
+		route, err := root.FindRoute(
+			"abd",
+			"fbc",
+			WithStrategy(strategy),
+			WithMaxDepth(test.maxDepth),
+			WithConstraints(Constraints{Waypoints: []string{"aec"}}),
+		)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s (strategy %v)",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+					strategy,
+				)
+			}
+			return
+		}
+
+		if len(route) != test.wants || !isValidRoute(root, route, "abd", "fbc") || !inCarry(route, "aec") {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted a %v-word route ; got %v -- action: %s (strategy %v)",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+				strategy,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		for _, strategy := range strategies {
+			verify(idx, test, strategy)
+		}
+	}
+}
+
+// followsConstraints function will return true if the input route follows the input Constraints
+func followsConstraints(route []string, c Constraints) bool {
+	seen := map[string]struct{}{}
+	next := 0
+
+	for _, w := range route {
+		if _, ok := seen[w]; ok {
+			return false
+		}
+		seen[w] = struct{}{}
+
+		for _, f := range c.Forbidden {
+			if w == f {
+				return false
+			}
+		}
+
+		if c.FixedLength && len(w) != len(route[0]) {
+			return false
+		}
+
+		if next < len(c.Waypoints) && w == c.Waypoints[next] {
+			next++
+		}
+	}
+
+	return next == len(c.Waypoints)
+}
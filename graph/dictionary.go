@@ -0,0 +1,77 @@
+package graph
+
+// Dictionary struct is a graph which attaches a value of type T to each of its words, such as its corpus frequency,
+// its part of speech or the list it was sourced from. The values are kept in the trie itself (in the node each word
+// ends in), so they follow the words as they are added to or removed from the dictionary.
+//
+// It embeds the graph's root Node, so the whole (string-only) API is available on it, such as Find(), Siblings()
+// or FindRoute(). Words added with Add() have no value attached, until one is set with AddWithValue().
+type Dictionary[T any] struct {
+	*Node
+}
+
+// NewDictionary function will create a new, empty Dictionary for values of type T, where the input Normalizers (if
+// any) are applied to its words as in New()
+func NewDictionary[T any](normalizers ...Normalizer) *Dictionary[T] {
+	return &Dictionary[T]{
+		Node: New(normalizers...),
+	}
+}
+
+// AddWithValue method will add the word to the dictionary (like Add() does), attaching the input value to it. If the
+// word already exists, its value is replaced. It returns false if the word is rejected by the dictionary's
+// Normalizer, or if it is not valid UTF-8.
+func (d *Dictionary[T]) AddWithValue(word string, value T) bool {
+	root := d.getRoot()
+	word, ok := root.normalize(word)
+
+	if !ok {
+		return false
+	}
+
+	root.Add(word)
+
+	nodes := root.GetNodes(word)
+
+	if len(nodes) == 0 {
+		return false
+	}
+
+	nodes[len(nodes)-1].payload = value
+
+	return true
+}
+
+// Get method will return the value attached to the input word (normalized like in Find()), and true if the word
+// exists in the dictionary. If the word exists without a value attached to it (for example, if it was added with
+// Add()), the zero value of T is returned.
+func (d *Dictionary[T]) Get(word string) (T, bool) {
+	var zero T
+
+	root := d.getRoot()
+	word, ok := root.normalize(word)
+
+	if !ok {
+		return zero, false
+	}
+
+	nodes := root.GetNodes(word)
+
+	if len(nodes) == 0 {
+		return zero, false
+	}
+
+	value, _ := nodes[len(nodes)-1].payload.(T)
+
+	return value, true
+}
+
+// Range method will call the input function with each word in the dictionary (in alphabetical order) and the value
+// attached to it, or the zero value of T if it has none
+func (d *Dictionary[T]) Range(fn func(word string, value T)) {
+	d.getRoot().rWords(nil, func(word string, node *Node) {
+		value, _ := node.payload.(T)
+
+		fn(word, value)
+	})
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDictionary(t *testing.T) {
+	module := "Dictionary"
+	funcname := "AddWithValue() >> Get()"
+
+	_ = module
+	_ = funcname
+
+	type entry struct {
+		Frequency int
+		Source    string
+	}
+
+	type test struct {
+		name  string
+		word  string
+		wants entry
+		ok    bool
+	}
+
+	dict := NewDictionary[entry](TrimSpace, FoldCase)
+	dict.AddWithValue("cat", entry{Frequency: 120, Source: "common"})
+	dict.AddWithValue("cats", entry{Frequency: 40, Source: "common"})
+	dict.AddWithValue("Cot ", entry{Frequency: 8, Source: "rare"})
+	dict.AddWithValue("dog", entry{Frequency: 90, Source: "common"})
+	dict.AddWithValue("dog", entry{Frequency: 95, Source: "updated"})
+	dict.Add("cog")
+	dict.Add("cat")
+	dict.Remove("cats")
+	dict.Add("cats")
+
+	var tests = []test{
+		{
+			name:  "word with a value",
+			word:  "cat",
+			wants: entry{Frequency: 120, Source: "common"},
+			ok:    true,
+		},
+		{
+			name:  "normalized word",
+			word:  " COT",
+			wants: entry{Frequency: 8, Source: "rare"},
+			ok:    true,
+		},
+		{
+			name:  "replaced value",
+			word:  "dog",
+			wants: entry{Frequency: 95, Source: "updated"},
+			ok:    true,
+		},
+		{
+			name: "word without a value",
+			word: "cog",
+			ok:   true,
+		},
+		{
+			name: "value dropped once the word is removed",
+			word: "cats",
+			ok:   true,
+		},
+		{
+			name: "prefix of a word",
+			word: "ca",
+		},
+		{
+			name: "word not in the dictionary",
+			word: "bat",
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		value, ok := dict.Get(test.word)
+
+		if ok != test.ok || value != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v (%v) ; got %+v (%v) -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				test.ok,
+				value,
+				ok,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// the string-only API is available on the dictionary
+	route, err := dict.FindRoute("cat", "dog", WithStrategy(BreadthFirst))
+
+	if err != nil || !reflect.DeepEqual(route, []string{"cat", "cot", "cog", "dog"}) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] unexpected route: %v (%v) -- action: %s",
+			len(tests),
+			module,
+			"FindRoute()",
+			route,
+			err,
+			"route in a dictionary",
+		)
+	}
+}
+
+func TestDictionaryRange(t *testing.T) {
+	module := "Dictionary"
+	funcname := "Range()"
+
+	_ = module
+	_ = funcname
+
+	dict := NewDictionary[int]()
+	dict.AddWithValue("dog", 3)
+	dict.AddWithValue("cat", 1)
+	dict.AddWithValue("cats", 2)
+	dict.Add("cot")
+
+	words := []string{}
+	values := []int{}
+
+	dict.Range(func(word string, value int) {
+		words = append(words, word)
+		values = append(values, value)
+	})
+
+	if !reflect.DeepEqual(words, []string{"cat", "cats", "cot", "dog"}) || !reflect.DeepEqual(values, []int{1, 2, 0, 3}) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] output mismatch error: got %v and %v -- action: %s",
+			0,
+			module,
+			funcname,
+			words,
+			values,
+			"range over words and values",
+		)
+	}
+}
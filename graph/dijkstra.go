@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"container/heap"
+	"context"
+	"unicode/utf8"
+)
+
+// Costs struct holds the cost of each type of single-character change between two words, used by the
+// Dijkstra strategy to look up the cheapest route (instead of the one with the fewest words).
+type Costs struct {
+	Substitute int // cost of replacing a character, e.g. "cat" to "cot"
+	Expand     int // cost of appending a character, e.g. "cat" to "cats"
+	Reduce     int // cost of removing the last character, e.g. "cats" to "cat"
+}
+
+// defaultCosts is the cost of each type of change if not set with WithCosts(): all changes cost the same,
+// so the cheapest route is also the shortest one
+var defaultCosts = Costs{
+	Substitute: 1,
+	Expand:     1,
+	Reduce:     1,
+}
+
+// step method will return the cost of the change from the word to its sibling, based on their length (in
+// characters)
+func (c Costs) step(word, sibling string) int {
+	switch wordLen, siblingLen := utf8.RuneCountInString(word), utf8.RuneCountInString(sibling); {
+	case siblingLen > wordLen:
+		return c.Expand
+	case siblingLen < wordLen:
+		return c.Reduce
+	default:
+		return c.Substitute
+	}
+}
+
+// ScoredRoute struct is a route along with the cost of each of its steps (from each word to the next one),
+// and the total cost of the route
+type ScoredRoute struct {
+	Words []string
+	Costs []int
+	Total int
+}
+
+// FindScoredRoute method will take an origin and target words, and return the cheapest route from one word to
+// the other (with single-character changes), along with the cost of each step and of the whole route.
+//
+// Each type of change costs 1 by default, which can be tuned with WithCosts(); for example, making changes to
+// the length of the words cost 2. Rare words can be penalized too, with WithRarityCost(). The route is always
+// looked up with the Dijkstra strategy, regardless of WithStrategy(), while the remaining Options apply as in
+// FindRoute().
+//
+// If there is no route between both words, ErrNoRoute is returned. If the query expires before it is complete,
+// a TimeoutError (matching ErrTimeout) is returned.
+func (n *Node) FindScoredRoute(origin, target string, opts ...Option) (*ScoredRoute, error) {
+	cfg := newConfig(opts...)
+	cfg.strategy = Dijkstra
+
+	route, err := n.route(context.Background(), cfg, origin, target)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return n.score(cfg, route), nil
+}
+
+// stepCost method will return the cost of the change from the word to its sibling, with the configured Costs,
+// plus the penalty for the sibling's rarity (if set with WithRarityCost())
+func (n *Node) stepCost(cfg *config, word, sibling string) int {
+	cost := cfg.costs.step(word, sibling)
+
+	if cfg.rarity > 0 {
+		cost += cfg.rarity * n.rarity(sibling)
+	}
+
+	return cost
+}
+
+// score method will return a ScoredRoute for the input route, with the configured costs
+func (n *Node) score(cfg *config, route []string) *ScoredRoute {
+	s := &ScoredRoute{
+		Words: route,
+		Costs: make([]int, 0, len(route)-1),
+	}
+
+	for i := 1; i < len(route); i++ {
+		cost := n.stepCost(cfg, route[i-1], route[i])
+
+		s.Costs = append(s.Costs, cost)
+		s.Total += cost
+	}
+
+	return s
+}
+
+// dijkstra method will look up the cheapest route from the origin to the target word, where each change costs
+// as configured with WithCosts() (and WithRarityCost()), by always expanding the word with the lowest cost so far.
+//
+// As all costs are positive, the first time the target is expanded the route leading to it is guaranteed to
+// be the cheapest one. Ties are broken by the number of steps, and then alphabetically, so the same query
+// against the same dictionary will always return the same route. The query halts once the input context is
+// done, returning its error.
+//
+// If a maximum depth is configured, words are not explored past it; in that case, a word may be expanded more
+// than once, as a costlier route with fewer steps can still reach the target within the maximum depth.
+func (n *Node) dijkstra(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	// work on the root for a complete word look-up
+	node := n.getRoot()
+
+	// without a maximum depth, the number of steps is not a part of the state
+	key := func(l *label) labelKey {
+		if cfg.maxDepth > 0 {
+			return labelKey{word: l.word, steps: l.steps}
+		}
+
+		return labelKey{word: l.word}
+	}
+
+	cost := map[labelKey]int{}          // state to the lowest cost to reach it
+	expanded := map[labelKey]struct{}{} // states whose siblings were already explored
+
+	open := &labels{{word: origin}}
+
+	for open.Len() > 0 {
+		// halt the query once the context is done
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		l := heap.Pop(open).(*label)
+
+		// once the target is expanded, its route is the cheapest one
+		if l.word == target {
+			return l.route(), nil
+		}
+
+		// skip stale entries for states that were reached with a lower cost afterwards
+		if _, ok := expanded[key(l)]; ok {
+			continue
+		}
+		expanded[key(l)] = struct{}{}
+
+		cfg.stats.Expanded++
+		siblings, err := node.allowedNeighbors(cfg, l.word)
+
+		// a word without siblings is a dead-end
+		if err != nil {
+			continue
+		}
+
+		for _, sibling := range siblings {
+			next := &label{
+				word:  sibling,
+				cost:  l.cost + node.stepCost(cfg, l.word, sibling),
+				steps: l.steps + 1,
+				prev:  l,
+			}
+
+			// skip words past the maximum depth (steps do not include the origin)
+			if cfg.maxDepth > 0 && next.steps+1 > cfg.maxDepth {
+				continue
+			}
+
+			// skip routes looping back to a word in them
+			if l.contains(sibling) {
+				continue
+			}
+
+			// skip states that were already reached with the same or a lower cost
+			if c, ok := cost[key(next)]; ok && c <= next.cost {
+				continue
+			}
+			cost[key(next)] = next.cost
+
+			heap.Push(open, next)
+		}
+	}
+
+	return nil, ErrNoRoute
+}
+
+// labelKey struct identifies a state in the Dijkstra search: a word, and (if a maximum depth is configured)
+// the number of steps taken to reach it
+type labelKey struct {
+	word  string
+	steps int
+}
+
+// label struct represents a word in the Dijkstra frontier, with the cost and number of steps it took to
+// reach it from the origin, and the label of the previous word in its route
+type label struct {
+	word  string
+	cost  int
+	steps int
+	prev  *label
+}
+
+// route method will return the words in the route leading to this label, from the origin
+func (l *label) route() []string {
+	route := make([]string, l.steps+1)
+
+	for cur := l; cur != nil; cur = cur.prev {
+		route[cur.steps] = cur.word
+	}
+
+	return route
+}
+
+// contains method will return true if the word is in the route leading to this label
+func (l *label) contains(word string) bool {
+	for cur := l; cur != nil; cur = cur.prev {
+		if cur.word == word {
+			return true
+		}
+	}
+
+	return false
+}
+
+// labels type is a priority queue of labels, implementing `heap.Interface`. The label with the lowest cost
+// is the first one to be popped.
+type labels []*label
+
+// Len method returns the number of labels in the queue
+func (l labels) Len() int {
+	return len(l)
+}
+
+// Less method compares two labels by their cost, then by their number of steps, then alphabetically
+func (l labels) Less(i, j int) bool {
+	if l[i].cost != l[j].cost {
+		return l[i].cost < l[j].cost
+	}
+
+	if l[i].steps != l[j].steps {
+		return l[i].steps < l[j].steps
+	}
+
+	return l[i].word < l[j].word
+}
+
+// Swap method swaps the labels in indexes i and j
+func (l labels) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+// Push method adds a new label to the queue
+func (l *labels) Push(x any) {
+	*l = append(*l, x.(*label))
+}
+
+// Pop method removes and returns the last label in the queue
+func (l *labels) Pop() any {
+	old := *l
+	item := old[len(old)-1]
+	*l = old[:len(old)-1]
+
+	return item
+}
@@ -0,0 +1,360 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFindScoredRoute(t *testing.T) {
+	module := "Dijkstra"
+	funcname := "FindScoredRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		origin string
+		target string
+		opts   []Option
+		wants  *ScoredRoute
+		err    error
+	}
+
+	// the shortest route from "ab" to "xy" changes the length of the words (ab, a, x, xy), while the only
+	// route keeping the same length is much longer (ab, qb, qc, pc, pd, xd, xy)
+	root := New()
+	root.Add("a", "x", "ab", "xy", "qb", "qc", "pc", "pd", "xd", "zz")
+
+	short := []string{"ab", "a", "x", "xy"}
+	long := []string{"ab", "qb", "qc", "pc", "pd", "xd", "xy"}
+
+	var tests = []test{
+		{
+			name:   "default costs",
+			origin: "ab",
+			target: "xy",
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 1, 1},
+				Total: 3,
+			},
+		},
+		{
+			name:   "length changes cost 2",
+			origin: "ab",
+			target: "xy",
+			opts:   []Option{WithCosts(Costs{Expand: 2, Reduce: 2})},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{2, 1, 2},
+				Total: 5,
+			},
+		},
+		{
+			name:   "length changes cost 5",
+			origin: "ab",
+			target: "xy",
+			opts:   []Option{WithCosts(Costs{Expand: 5, Reduce: 5})},
+			wants: &ScoredRoute{
+				Words: long,
+				Costs: []int{1, 1, 1, 1, 1, 1},
+				Total: 6,
+			},
+		},
+		{
+			name:   "cheapest route past the maximum depth",
+			origin: "ab",
+			target: "xy",
+			opts:   []Option{WithCosts(Costs{Expand: 5, Reduce: 5}), WithMaxDepth(4)},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{5, 1, 5},
+				Total: 11,
+			},
+		},
+		{
+			name:   "strategy option is overridden",
+			origin: "ab",
+			target: "xy",
+			opts:   []Option{WithStrategy(BreadthFirst), WithCosts(Costs{Reduce: 5})},
+			wants: &ScoredRoute{
+				Words: long,
+				Costs: []int{1, 1, 1, 1, 1, 1},
+				Total: 6,
+			},
+		},
+		{
+			name:   "with constraints",
+			origin: "ab",
+			target: "xy",
+			opts:   []Option{WithConstraints(Constraints{Forbidden: []string{"pc"}})},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 1, 1},
+				Total: 3,
+			},
+		},
+		{
+			name:   "no route",
+			origin: "ab",
+			target: "zz",
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "same word",
+			origin: "ab",
+			target: "ab",
+			err:    ErrSameWord,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := root.FindScoredRoute(test.origin, test.target, test.opts...)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindScoredRouteRarity(t *testing.T) {
+	module := "Dijkstra"
+	funcname := "WithRarityCost()"
+
+	type test struct {
+		name  string
+		freq  Frequencies
+		opts  []Option
+		wants *ScoredRoute
+	}
+
+	// the shortest route from "ab" to "cd" goes through "ad", while a longer one goes through
+	// "eb" and "ed"
+	root := New()
+	root.Add("ab", "ad", "cd", "eb", "ed")
+
+	short := []string{"ab", "ad", "cd"}
+	long := []string{"ab", "eb", "ed", "cd"}
+
+	common := Frequencies{"ab": 5000, "cd": 5000, "eb": 2000, "ed": 4000}
+
+	var tests = []test{
+		{
+			name: "no frequencies",
+			opts: []Option{WithRarityCost(1)},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 1},
+				Total: 2,
+			},
+		},
+		{
+			name: "rare words are not penalized by default",
+			freq: common,
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 1},
+				Total: 2,
+			},
+		},
+		{
+			name: "non-positive rarity cost is ignored",
+			freq: common,
+			opts: []Option{WithRarityCost(0)},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 1},
+				Total: 2,
+			},
+		},
+		{
+			name: "route around a rare word",
+			freq: common,
+			opts: []Option{WithRarityCost(1)},
+			wants: &ScoredRoute{
+				Words: long,
+				Costs: []int{1, 1, 1},
+				Total: 3,
+			},
+		},
+		{
+			name: "penalty included in the costs",
+			freq: Frequencies{"ab": 5000, "ad": 5000, "cd": 10, "eb": 2000, "ed": 4000},
+			opts: []Option{WithRarityCost(1)},
+			wants: &ScoredRoute{
+				Words: short,
+				Costs: []int{1, 3},
+				Total: 4,
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		root.SetFrequencies(test.freq)
+
+		route, err := root.FindScoredRoute("ab", "cd", test.opts...)
+
+		if err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteRarity(t *testing.T) {
+	module := "Dijkstra"
+	funcname := "WithRarityCost()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		query func(root *Node) (interface{}, error)
+		wants interface{}
+		err   error
+	}
+
+	// the shortest route from "ab" to "cd" goes through the rare word "ad"
+	root := New()
+	root.Add("ab", "ad", "cd", "eb", "ed")
+	root.SetFrequencies(Frequencies{"ab": 5000, "cd": 5000, "eb": 2000, "ed": 4000})
+
+	var tests = []test{
+		{
+			name: "default strategy",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("ab", "cd", WithRarityCost(1))
+			},
+			wants: []string{"ab", "eb", "ed", "cd"},
+		},
+		{
+			name: "Dijkstra strategy",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("ab", "cd", WithRarityCost(1), WithStrategy(Dijkstra))
+			},
+			wants: []string{"ab", "eb", "ed", "cd"},
+		},
+		{
+			name: "another strategy",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("ab", "cd", WithRarityCost(1), WithStrategy(BreadthFirst))
+			},
+			err: ErrUnsupported,
+		},
+		{
+			name: "k shortest routes",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoutes("ab", "cd", 2, WithRarityCost(1))
+			},
+			err: ErrUnsupported,
+		},
+		{
+			name: "batch",
+			query: func(root *Node) (interface{}, error) {
+				results := root.FindRoutesBatch([]Pair{{Origin: "ab", Target: "cd"}}, WithRarityCost(1))
+
+				return results[0].Route, results[0].Err
+			},
+			err: ErrUnsupported,
+		},
+		{
+			name: "stream with another strategy",
+			query: func(root *Node) (interface{}, error) {
+				return root.StreamRoutes(context.Background(), "ab", "cd", WithRarityCost(1), WithStrategy(AStar))
+			},
+			err: ErrUnsupported,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out, err := test.query(root)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(out, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				out,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operation type represents the single-character change that takes a word in a route to the next one
+type Operation int
+
+const (
+	OpNone       Operation = iota // no change; the first word in a route
+	OpSubstitute                  // a character is replaced by another one, e.g. "cat" to "cot"
+	OpInsert                      // a character is added, e.g. "cat" to "cats"
+	OpDelete                      // a character is removed, e.g. "cats" to "cat"
+)
+
+// String method will return the name of the operation, as used in its JSON representation
+func (o Operation) String() string {
+	switch o {
+	case OpSubstitute:
+		return "substitute"
+	case OpInsert:
+		return "insert"
+	case OpDelete:
+		return "delete"
+	default:
+		return "none"
+	}
+}
+
+// MarshalText method will encode the operation as its name, implementing `encoding.TextMarshaler`
+func (o Operation) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText method will decode the operation from its name, implementing `encoding.TextUnmarshaler`
+func (o *Operation) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "substitute":
+		*o = OpSubstitute
+	case "insert":
+		*o = OpInsert
+	case "delete":
+		*o = OpDelete
+	case "none", "":
+		*o = OpNone
+	default:
+		return fmt.Errorf("unknown operation: %q", text)
+	}
+
+	return nil
+}
+
+// Step struct represents a word in a Route, along with the change that led to it from the previous word:
+// the operation, the (0-indexed) position of the changed character (counted in characters, not bytes), and the old
+// and new characters.
+//
+// For the first word in a route, the operation is OpNone and the remaining fields are empty. For an OpInsert
+// step Old is empty, and for an OpDelete step New is empty.
+type Step struct {
+	Word string    `json:"word"`
+	Op   Operation `json:"op"`
+	Pos  int       `json:"pos"`
+	Old  string    `json:"old,omitempty"`
+	New  string    `json:"new,omitempty"`
+}
+
+// Route type is a route between two words, where each Step explains the change from the previous word.
+// It is encoded to JSON as a list of steps.
+type Route []Step
+
+// NewRoute function will create a Route out of the input words (such as the ones returned by FindRoute()),
+// working out the change between each pair of consecutive words.
+//
+// It returns ErrInvalidStep if any two consecutive words are not one change apart.
+func NewRoute(words []string) (Route, error) {
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	route := make(Route, 0, len(words))
+	route = append(route, Step{Word: words[0]})
+
+	for i := 1; i < len(words); i++ {
+		step, ok := diff(words[i-1], words[i])
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %q to %q", ErrInvalidStep, words[i-1], words[i])
+		}
+
+		route = append(route, step)
+	}
+
+	return route, nil
+}
+
+// diff function will return the Step from the word to the next one, or false if they are not one change apart.
+// Words are compared character by character (as runes).
+func diff(word, next string) (Step, bool) {
+	step := Step{Word: next}
+	a, b := []rune(word), []rune(next)
+
+	// find the first differing character
+	pos := 0
+	for pos < len(a) && pos < len(b) && a[pos] == b[pos] {
+		pos++
+	}
+	step.Pos = pos
+
+	switch {
+	case len(b) == len(a) && pos < len(a) && string(a[pos+1:]) == string(b[pos+1:]):
+		step.Op = OpSubstitute
+		step.Old = string(a[pos])
+		step.New = string(b[pos])
+	case len(b) == len(a)+1 && string(a[pos:]) == string(b[pos+1:]):
+		step.Op = OpInsert
+		step.New = string(b[pos])
+	case len(b) == len(a)-1 && string(a[pos+1:]) == string(b[pos:]):
+		step.Op = OpDelete
+		step.Old = string(a[pos])
+	default:
+		return Step{}, false
+	}
+
+	return step, true
+}
+
+// Words method will return the words in the route
+func (r Route) Words() []string {
+	words := make([]string, 0, len(r))
+
+	for _, s := range r {
+		words = append(words, s.Word)
+	}
+
+	return words
+}
+
+// String method will return a string representation of the route, describing each change; for example:
+//
+//	cat -> cot (substitute "a" with "o" at 1) -> cots (insert "s" at 3)
+func (r Route) String() string {
+	var sb = &strings.Builder{}
+
+	for idx, s := range r {
+		if idx > 0 {
+			sb.WriteString(" -> ")
+		}
+
+		sb.WriteString(s.Word)
+
+		switch s.Op {
+		case OpSubstitute:
+			sb.WriteString(" (substitute ")
+			sb.WriteString(strconv.Quote(s.Old))
+			sb.WriteString(" with ")
+			sb.WriteString(strconv.Quote(s.New))
+		case OpInsert:
+			sb.WriteString(" (insert ")
+			sb.WriteString(strconv.Quote(s.New))
+		case OpDelete:
+			sb.WriteString(" (delete ")
+			sb.WriteString(strconv.Quote(s.Old))
+		default:
+			continue
+		}
+
+		sb.WriteString(" at ")
+		sb.WriteString(strconv.Itoa(s.Pos))
+		sb.WriteString(")")
+	}
+
+	return sb.String()
+}
+
+const (
+	ansiReset   = "\x1b[0m"    // resets all text attributes
+	ansiChanged = "\x1b[1;32m" // bold green, for substituted and inserted characters
+	ansiDeleted = "\x1b[9;31m" // crossed-out red, for deleted characters
+)
+
+// ANSI method will return a representation of the route for terminals, with its words separated by arrows
+// and the changed character in each word highlighted with ANSI escape codes: substituted and inserted
+// characters in bold green, and deleted characters (shown in place) crossed-out in red.
+//
+// Steps whose position does not fit their word (such as the ones decoded from malformed JSON) are shown as plain
+// words, without highlighting.
+func (r Route) ANSI() string {
+	var sb = &strings.Builder{}
+
+	for idx, s := range r {
+		if idx > 0 {
+			sb.WriteString(" -> ")
+		}
+
+		// positions are counted in characters, not bytes
+		chars := []rune(s.Word)
+
+		switch {
+		case (s.Op == OpSubstitute || s.Op == OpInsert) && s.Pos >= 0 && s.Pos < len(chars):
+			sb.WriteString(string(chars[:s.Pos]))
+			sb.WriteString(ansiChanged)
+			sb.WriteString(s.New)
+			sb.WriteString(ansiReset)
+			sb.WriteString(string(chars[s.Pos+1:]))
+		case s.Op == OpDelete && s.Pos >= 0 && s.Pos <= len(chars):
+			sb.WriteString(string(chars[:s.Pos]))
+			sb.WriteString(ansiDeleted)
+			sb.WriteString(s.Old)
+			sb.WriteString(ansiReset)
+			sb.WriteString(string(chars[s.Pos:]))
+		default:
+			sb.WriteString(s.Word)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewRoute(t *testing.T) {
+	module := "Route"
+	funcname := "NewRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		words []string
+		wants Route
+		err   error
+	}
+
+	var tests = []test{
+		{
+			name:  "substitutions, inserts and deletions",
+			words: []string{"cat", "cot", "cots", "cot"},
+			wants: Route{
+				{Word: "cat"},
+				{Word: "cot", Op: OpSubstitute, Pos: 1, Old: "a", New: "o"},
+				{Word: "cots", Op: OpInsert, Pos: 3, New: "s"},
+				{Word: "cot", Op: OpDelete, Pos: 3, Old: "s"},
+			},
+		},
+		{
+			name:  "changes at the edges of the words",
+			words: []string{"cat", "bat", "at", "art"},
+			wants: Route{
+				{Word: "cat"},
+				{Word: "bat", Op: OpSubstitute, Pos: 0, Old: "c", New: "b"},
+				{Word: "at", Op: OpDelete, Pos: 0, Old: "b"},
+				{Word: "art", Op: OpInsert, Pos: 1, New: "r"},
+			},
+		},
+		{
+			name:  "multi-byte characters",
+			words: []string{"pão", "mão", "mãe", "mães", "mãe"},
+			wants: Route{
+				{Word: "pão"},
+				{Word: "mão", Op: OpSubstitute, Pos: 0, Old: "p", New: "m"},
+				{Word: "mãe", Op: OpSubstitute, Pos: 2, Old: "o", New: "e"},
+				{Word: "mães", Op: OpInsert, Pos: 3, New: "s"},
+				{Word: "mãe", Op: OpDelete, Pos: 3, Old: "s"},
+			},
+		},
+		{
+			name:  "changes to multi-byte characters",
+			words: []string{"pá", "pó", "p", "pé"},
+			wants: Route{
+				{Word: "pá"},
+				{Word: "pó", Op: OpSubstitute, Pos: 1, Old: "á", New: "ó"},
+				{Word: "p", Op: OpDelete, Pos: 1, Old: "ó"},
+				{Word: "pé", Op: OpInsert, Pos: 1, New: "é"},
+			},
+		},
+		{
+			name:  "single word",
+			words: []string{"cat"},
+			wants: Route{{Word: "cat"}},
+		},
+		{
+			name: "no words",
+		},
+		{
+			name:  "words more than one change apart",
+			words: []string{"cat", "dog"},
+			err:   ErrInvalidStep,
+		},
+		{
+			name:  "repeated word",
+			words: []string{"cat", "cat"},
+			err:   ErrInvalidStep,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := NewRoute(test.words)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+
+		if words := route.Words(); len(test.words) > 0 && !reflect.DeepEqual(words, test.words) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] words mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.words,
+				words,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestRouteRender(t *testing.T) {
+	module := "Route"
+	funcname := "String() / ANSI() / MarshalJSON()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		render func(Route) string
+		wants  string
+	}
+
+	route, err := NewRoute([]string{"cat", "cot", "cots", "cot"})
+
+	if err != nil {
+		t.Fatalf("unexpected error creating the route: %v", err)
+	}
+
+	var tests = []test{
+		{
+			name:   "string",
+			render: Route.String,
+			wants:  `cat -> cot (substitute "a" with "o" at 1) -> cots (insert "s" at 3) -> cot (delete "s" at 3)`,
+		},
+		{
+			name:   "ANSI",
+			render: Route.ANSI,
+			wants:  "cat -> c\x1b[1;32mo\x1b[0mt -> cot\x1b[1;32ms\x1b[0m -> cot\x1b[9;31ms\x1b[0m",
+		},
+		{
+			name: "ANSI with multi-byte characters",
+			render: func(Route) string {
+				r, _ := NewRoute([]string{"pão", "mão", "mãe", "mães"})
+				return r.ANSI()
+			},
+			wants: "pão -> \x1b[1;32mm\x1b[0mão -> mã\x1b[1;32me\x1b[0m -> mãe\x1b[1;32ms\x1b[0m",
+		},
+		{
+			name: "ANSI with positions out of range",
+			render: func(Route) string {
+				var r Route
+
+				// decoded steps are not checked against their words
+				err := json.Unmarshal([]byte(`[{"word":"cat","op":"none","pos":0},`+
+					`{"word":"cot","op":"substitute","pos":-1,"old":"a","new":"o"},`+
+					`{"word":"cots","op":"insert","pos":5,"new":"s"},`+
+					`{"word":"cot","op":"delete","pos":4,"old":"s"}]`), &r)
+
+				if err != nil {
+					return err.Error()
+				}
+
+				return r.ANSI()
+			},
+			wants: "cat -> cot -> cots -> cot",
+		},
+		{
+			name: "JSON",
+			render: func(r Route) string {
+				b, _ := json.Marshal(r)
+				return string(b)
+			},
+			wants: `[{"word":"cat","op":"none","pos":0},` +
+				`{"word":"cot","op":"substitute","pos":1,"old":"a","new":"o"},` +
+				`{"word":"cots","op":"insert","pos":3,"new":"s"},` +
+				`{"word":"cot","op":"delete","pos":3,"old":"s"}]`,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out := test.render(route)
+
+		if out != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q ; got %q -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				out,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// JSON round-trip
+	b, err := json.Marshal(route)
+
+	if err != nil {
+		t.Fatalf("unexpected error encoding the route: %v", err)
+	}
+
+	var decoded Route
+
+	if err := json.Unmarshal(b, &decoded); err != nil || !reflect.DeepEqual(decoded, route) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] JSON round-trip mismatch error: wanted %+v ; got %+v (%v) -- action: %s",
+			len(tests),
+			module,
+			funcname,
+			route,
+			decoded,
+			err,
+			"JSON round-trip",
+		)
+	}
+}
@@ -0,0 +1,104 @@
+package graph
+
+// Frequencies type maps words to their count, such as their number of occurrences in a corpus; as loaded from a
+// frequency list with FromFrequencyList(). The higher the count, the more common the word.
+type Frequencies map[string]int
+
+// frequencies struct holds the Frequencies set on a dictionary, with its words normalized, along with the highest
+// count in them
+type frequencies struct {
+	counts Frequencies
+	max    int
+}
+
+// SetFrequencies method will set the Frequencies of the words in the dictionary, replacing the ones set before (if
+// any). The words are normalized with the dictionary's Normalizer (see New()), adding up the counts of the words
+// which are normalized into the same one; rejected words are skipped. Words in the dictionary which are missing
+// from the input Frequencies have a count of zero.
+//
+// The Frequencies are used by TargetSiblings() to rank equally relevant siblings, putting the most common words
+// first; and by the Dijkstra strategy to penalize rare words, with WithRarityCost().
+//
+// Like Add(), it is not safe to call while querying the dictionary.
+func (n *Node) SetFrequencies(freq Frequencies) {
+	root := n.getRoot()
+
+	// a root node not created with New() has no state yet
+	if root.dict == nil {
+		root.dict = &dictState{}
+	}
+
+	if len(freq) == 0 {
+		root.dict.frequencies = nil
+		return
+	}
+
+	f := &frequencies{
+		counts: make(Frequencies, len(freq)),
+	}
+
+	for w, count := range freq {
+		word, ok := root.normalize(w)
+
+		if !ok {
+			continue
+		}
+
+		f.counts[word] += count
+	}
+
+	for _, count := range f.counts {
+		if count > f.max {
+			f.max = count
+		}
+	}
+
+	root.dict.frequencies = f
+}
+
+// Frequency method will return the count of the input word (normalized like in Find()), as set with
+// SetFrequencies(); or zero if it has none.
+func (n *Node) Frequency(word string) int {
+	word, ok := n.normalize(word)
+
+	if !ok {
+		return 0
+	}
+
+	return n.frequency(word)
+}
+
+// frequency method will return the count of the word as it is stored in the dictionary, or zero if it has none
+func (n *Node) frequency(word string) int {
+	f := n.state().frequencies
+
+	if f == nil {
+		return 0
+	}
+
+	return f.counts[word]
+}
+
+// rarity method will return how rare the word is, as the number of orders of magnitude (powers of 10) its count is
+// below the highest count in the dictionary's Frequencies: zero for the most common words, and one more for each
+// digit they lack. Words without a count are the rarest ones. Without Frequencies, all words have a rarity of zero.
+func (n *Node) rarity(word string) int {
+	f := n.state().frequencies
+
+	if f == nil {
+		return 0
+	}
+
+	return digits(f.max) - digits(f.counts[word])
+}
+
+// digits function will return the number of decimal digits in a non-negative integer, where zero has none
+func digits(value int) int {
+	var count int
+
+	for ; value > 0; value /= 10 {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestSetFrequencies(t *testing.T) {
+	module := "Graph"
+	funcname := "SetFrequencies()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name      string
+		word      string
+		frequency int
+		rarity    int
+	}
+
+	root := New(TrimSpace, FoldCase, AllowOnly(unicode.Letter))
+	root.Add("cat", "cot", "dog", "aalii")
+	root.SetFrequencies(Frequencies{
+		"Cat":   100,
+		"cat ":  20,
+		"dog":   12000,
+		"aalii": 3,
+		"c4t":   9000,
+	})
+
+	var tests = []test{
+		{
+			name:      "normalized counts are added up",
+			word:      "CAT",
+			frequency: 120,
+			rarity:    2,
+		},
+		{
+			name:      "most common word",
+			word:      "dog",
+			frequency: 12000,
+			rarity:    0,
+		},
+		{
+			name:      "rare word",
+			word:      "aalii",
+			frequency: 3,
+			rarity:    4,
+		},
+		{
+			name:      "word without a count",
+			word:      "cot",
+			frequency: 0,
+			rarity:    5,
+		},
+		{
+			name:      "rejected word",
+			word:      "c4t",
+			frequency: 0,
+			rarity:    5,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		frequency := root.Frequency(test.word)
+		word, _ := root.normalize(test.word)
+		rarity := root.rarity(word)
+
+		if frequency != test.frequency || rarity != test.rarity {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v (rarity %v) ; got %v (rarity %v) -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.frequency,
+				test.rarity,
+				frequency,
+				rarity,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// clearing the frequencies
+	root.SetFrequencies(nil)
+
+	if frequency, rarity := root.Frequency("dog"), root.rarity("cot"); frequency != 0 || rarity != 0 {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted 0 (rarity 0) ; got %v (rarity %v) -- action: %s",
+			len(tests),
+			module,
+			funcname,
+			frequency,
+			rarity,
+			"clear the frequencies",
+		)
+	}
+}
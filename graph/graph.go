@@ -1,7 +1,9 @@
 package graph
 
 import (
+	"sort"
 	"strings"
+	"unicode/utf8"
 )
 
 // Node struct is a graph data structure that is non-cyclical, uni-directional, unweighted and map-based
@@ -13,12 +15,11 @@ import (
 // and provide objects (Result) that provide insight (and weight values) on the output for a certain query.
 //
 // The Node struct will contain the following elements:
-//   - charMap map[byte]*Node; this element is a matrix of all the letters in the alphabet, which are linked to
-//   a pointer to a Node. This represents the next character in a word. For the English language, this does not
-//   require a different format such as a rune map (instead of byte); and this implementation is using bytes for
-//   simplicity reasons.
+//   - charMap map[rune]*Node; this element is a matrix of all the letters in the alphabet, which are linked to
+//   a pointer to a Node. This represents the next character in a word. It is keyed by runes (and not bytes) so
+//   that words with multi-byte characters, such as "café" or "straße", are stored one character per Node.
 //
-//   - char byte; a quick reference to the current character this Node represents. It can be zero if it's the root;
+//   - char rune; a quick reference to the current character this Node represents. It can be zero if it's the root;
 //   however for that check, the parent element is used instead.
 //
 //   - isEnd bool; is a placeholder delimiter for end-of-words. Just because isEnd == true, may not mean that there
@@ -27,16 +28,63 @@ import (
 //
 //   - parent *Node; points to the node above this one. The root node will have this element set to nil, and to get to
 //   the root node, it's only needed to traverse this pointer until it's nil.
+//
+//   - dict *dictState; holds the state of the whole dictionary (such as the indexes built over it), which is only
+//   set in the root node. Keeping it behind a single pointer keeps the remaining nodes small.
+//
+//   - payload any; is the value attached to the word ending in this node (see Dictionary), if any. It is only set
+//   in nodes which mark the end of a word.
 type Node struct {
-	charMap map[byte]*Node
-	char    byte
+	charMap map[rune]*Node
+	char    rune
 	isEnd   bool
 	parent  *Node
+	dict    *dictState
+	payload any
 }
 
-// New function will create a new Node pointer, with an already initialized charMap.
-func New() *Node {
-	return &Node{charMap: map[byte]*Node{}}
+// dictState struct holds the state of a dictionary, which is only kept in its root node (see Node):
+//   - index *index; holds the indexes built over the whole dictionary (such as its connected components). They are
+//     built on demand and kept up to date as the dictionary changes.
+//   - normalizer Normalizer; is applied to the words added to the dictionary and to the words in its queries. It is
+//     nil if the words are used verbatim.
+//   - frequencies *frequencies; holds the count of each word, as set with SetFrequencies(). It is nil if no counts
+//     were set.
+type dictState struct {
+	index       *index
+	normalizer  Normalizer
+	frequencies *frequencies
+}
+
+// state method will return the state of the dictionary, kept in the root node. It is empty (but not nil) for a
+// root node not created with New().
+func (n *Node) state() *dictState {
+	root := n.getRoot()
+
+	if root.dict == nil {
+		return &dictState{}
+	}
+
+	return root.dict
+}
+
+// New function will create a new Node pointer, with an already initialized charMap and dictionary state.
+//
+// The input Normalizers (if any) are chained into the dictionary's normalization pipeline (see Pipeline()), which is
+// applied to all words added to it, and to all words in its queries. For example:
+//
+//	graph.New(graph.TrimSpace, graph.NFC, graph.FoldCase, graph.AllowOnly(unicode.Letter))
+func New(normalizers ...Normalizer) *Node {
+	n := &Node{
+		charMap: map[rune]*Node{},
+		dict:    &dictState{index: &index{}},
+	}
+
+	if len(normalizers) > 0 {
+		n.dict.normalizer = Pipeline(normalizers...)
+	}
+
+	return n
 }
 
 // getRoot method is private, and is used by (mostly public) methods to get to the top-level node, or root node.
@@ -58,6 +106,9 @@ func (n *Node) getRoot() *Node {
 //
 // If the node is already present, it will recursively call `rAdd()` on that child node, popping one character from
 // the beginning of the word until it's stored.
+//
+// Words are normalized with the dictionary's Normalizer (see New()) before being stored; the words it rejects are
+// skipped.
 func (n *Node) Add(word ...string) {
 	// short-circuit if the input is empty
 	if len(word) == 0 {
@@ -67,10 +118,23 @@ func (n *Node) Add(word ...string) {
 	// ensure the call is done on the root node
 	node := n.getRoot()
 
-	// iterate through all words, calling `rAdd()` on each
+	added := make([]string, 0, len(word))
+
+	// iterate through all words, calling `rAdd()` on each; skipping the ones rejected by the dictionary's
+	// Normalizer, and the ones which are not valid UTF-8, as their characters cannot be told apart
 	for _, w := range word {
+		w, ok := node.normalize(w)
+
+		if !ok || !utf8.ValidString(w) {
+			continue
+		}
+
 		node.rAdd(w)
+		added = append(added, w)
 	}
+
+	// update the indexes with the new words
+	node.state().index.added(node, added...)
 }
 
 // rAdd method will recursively add a word to the graph.
@@ -89,35 +153,96 @@ func (n *Node) rAdd(word string) {
 	}
 
 	// take the first character in the word
-	char := word[0]
+	char, size := utf8.DecodeRuneInString(word)
 
 	// if it doesn't exist in the map; populate it with a new pointer
 	if n.charMap[char] == nil {
 
 		n.charMap[char] = &Node{
-			charMap: map[byte]*Node{},
+			charMap: map[rune]*Node{},
 			char:    char,
 			parent:  n,
 		}
+	}
+
+	// if this is the last character, set node's isEnd as true; even if the node already existed, as the word
+	// may be the prefix of a word added before it
+	if len(word) == size {
+		n.charMap[char].isEnd = true
+		return
+	}
+
+	// continue until input is empty
+	n.charMap[char].rAdd(word[size:])
+}
+
+// Remove method will be variadic, taking any number of strings to remove from the graph / dictionary. It returns
+// the number of words actually removed; words which do not exist in the dictionary (or which are repeated in the
+// input) are skipped. Words are normalized like in Add().
+//
+// It does so by calling `rRemove()` on the last node of each word, which unsets its `isEnd` element and prunes the
+// nodes which no longer lead to any word, up through their parents.
+//
+// Once done, the indexes built over the dictionary (if any) are updated, by only recomputing the neighborhoods of
+// the removed words.
+func (n *Node) Remove(word ...string) int {
+	// short-circuit if the input is empty
+	if len(word) == 0 {
+		return 0
+	}
+
+	// ensure the call is done on the root node
+	node := n.getRoot()
+	removed := make([]string, 0, len(word))
+
+	for _, w := range word {
+		w, ok := node.normalize(w)
+
+		if !ok {
+			continue
+		}
+
+		nodes := node.GetNodes(w)
 
-		// if this is the last character, set node's isEnd as true
-		if len(word) == 1 {
-			n.charMap[char].isEnd = true
-			return
+		// skip words which do not exist in the dictionary
+		if len(nodes) == 0 {
+			continue
 		}
 
-		// continue until input is empty
-		n.charMap[char].rAdd(word[1:])
+		nodes[len(nodes)-1].rRemove()
+		removed = append(removed, w)
+	}
+
+	// update the indexes with the removed words
+	node.state().index.removed(removed...)
+
+	return len(removed)
+}
+
+// rRemove method will unset the node's `isEnd` element (dropping its payload), and recursively prune it from its parent's charMap
+// while it has no children and does not mark the end of a word; climbing up until it reaches a node which is
+// still in use (or the root node).
+func (n *Node) rRemove() {
+	n.isEnd = false
+	n.payload = nil
+
+	// stop at the root node, or at a node still leading to other words
+	if n.parent == nil || len(n.charMap) > 0 {
 		return
 	}
 
-	// continue until input is empty
-	n.charMap[char].rAdd(word[1:])
+	delete(n.parent.charMap, n.char)
+
+	// the parent node may now be a dangling branch, unless it marks the end of a word
+	if !n.parent.isEnd {
+		n.parent.rRemove()
+	}
 }
 
-// Byte method returns the node's representative character, in bytes
+// Byte method returns the node's representative character, in bytes. Characters outside of the ASCII range do
+// not fit in a byte and are truncated; use Rune() for those instead.
 func (n *Node) Byte() byte {
-	return n.char
+	return byte(n.char)
 }
 
 // String method returns the node's representative character.
@@ -127,7 +252,65 @@ func (n *Node) String() string {
 
 // Rune method returns the node's representative character, as a rune
 func (n *Node) Rune() rune {
-	return rune(n.char)
+	return n.char
+}
+
+// Child method returns the node's child for the input character, or nil if it has none. The character is used
+// verbatim, as the dictionary's Normalizer is applied to whole words and not to single characters.
+func (n *Node) Child(char rune) *Node {
+	return n.charMap[char]
+}
+
+// Children method returns all of the node's children, sorted by their character (in alphabetical order, as the
+// words are listed). It returns an empty slice if the node has no children.
+func (n *Node) Children() []*Node {
+	children := make([]*Node, 0, len(n.charMap))
+
+	for _, child := range n.charMap {
+		children = append(children, child)
+	}
+
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].char < children[j].char
+	})
+
+	return children
+}
+
+// Parent method returns the node's parent, or nil if it is the root node
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+// IsWord method returns true if the node is the last character of a word in the dictionary. The word itself is
+// returned by Word().
+func (n *Node) IsWord() bool {
+	return n.isEnd
+}
+
+// Depth method returns the number of characters from the root node to this node; which is zero for the root node,
+// and the length (in runes) of Word() for the remaining nodes.
+func (n *Node) Depth() int {
+	var depth int
+
+	for node := n; node.parent != nil; node = node.parent {
+		depth++
+	}
+
+	return depth
+}
+
+// Word method rebuilds the string leading up to this node, by walking up through its parents to the root node
+// (which returns an empty string). This string is only a word in the dictionary if IsWord() returns true; otherwise
+// it is a prefix of one.
+func (n *Node) Word() string {
+	chars := make([]rune, n.Depth())
+
+	for node, idx := n, len(chars)-1; node.parent != nil; node, idx = node.parent, idx-1 {
+		chars[idx] = node.char
+	}
+
+	return string(chars)
 }
 
 // Print method will pretty-print a list-like relationship between all elements of this node and
@@ -148,7 +331,7 @@ func (n *Node) Print(sep, brk string) string {
 		sb.WriteString(sep)
 		sb.WriteString(brk)
 		sb.WriteString("[")
-		sb.WriteByte(b)
+		sb.WriteRune(b)
 		sb.WriteString("]")
 		if nd.isEnd {
 			sb.WriteString(" ;")
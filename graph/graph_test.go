@@ -4,6 +4,8 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"unicode/utf8"
+	"unsafe"
 )
 
 func FuzzAddAndFind(f *testing.F) {
@@ -15,8 +17,15 @@ func FuzzAddAndFind(f *testing.F) {
 	f.Add("cot")
 	f.Add("dot")
 	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
 
 	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
 		n := New()
 		n.Add(a)
 
@@ -34,6 +43,113 @@ func FuzzAddAndFind(f *testing.F) {
 	})
 }
 
+func FuzzAddAndRemove(f *testing.F) {
+	module := "Graph"
+	funcname := "Add() >> Remove() >> Find()"
+	action := "fuzz testing graph's Add(...string) with two words, then Remove(...string) on the first, then Find(string)"
+
+	f.Add("cat", "cot")
+	f.Add("cat", "cats")
+	f.Add("cats", "cat")
+	f.Add("dog", "dog")
+	f.Add("", "dog")
+	f.Add("café", "cafés")
+	f.Add("straße", "strasse")
+
+	f.Fuzz(func(t *testing.T, a, b string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) || !utf8.ValidString(b) {
+			t.Skip()
+		}
+
+		n := New()
+		n.Add(a, b)
+
+		wants := 0
+		if len(a) > 0 {
+			wants = 1
+		}
+
+		if removed := n.Remove(a); removed != wants {
+			t.Errorf(
+				"FAILED -- [%s] [%s] unexpected number of removed words: wanted %v ; got %v; input: %q, %q -- action: %s",
+				module,
+				funcname,
+				wants,
+				removed,
+				a,
+				b,
+				action,
+			)
+			return
+		}
+
+		// the other word must be left untouched, unless it is the same word
+		if n.Find(a) || n.Find(b) != (len(b) > 0 && a != b) {
+			t.Errorf(
+				"FAILED -- [%s] [%s] unexpected dictionary after removing %q (having added %q) -- action: %s",
+				module,
+				funcname,
+				a,
+				b,
+				action,
+			)
+			return
+		}
+
+		// removing the other word must prune the whole trie
+		n.Remove(b)
+
+		if len(n.charMap) > 0 {
+			t.Errorf(
+				"FAILED -- [%s] [%s] dangling nodes after removing all words: %v; input: %q, %q -- action: %s",
+				module,
+				funcname,
+				n.Print("", "\n"),
+				a,
+				b,
+				action,
+			)
+			return
+		}
+	})
+}
+
+func FuzzRemoveAndAdd(f *testing.F) {
+	module := "Graph"
+	funcname := "Remove() >> Add() >> Find()"
+	action := "fuzz testing graph's Remove(...string) on an added word, then Add(...string) with it again"
+
+	f.Add("cat", "cot")
+	f.Add("cat", "cats")
+	f.Add("cats", "cat")
+	f.Add("café", "cafe")
+
+	f.Fuzz(func(t *testing.T, a, b string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) || !utf8.ValidString(b) {
+			t.Skip()
+		}
+
+		n := New()
+		n.Add(a, b)
+		n.Remove(a)
+		n.Add(a)
+
+		if (len(a) > 0 && !n.Find(a)) || (len(b) > 0 && a != b && !n.Find(b)) {
+			t.Errorf(
+				"FAILED -- [%s] [%s] unable to find the added words %q and %q in the graph -- action: %s",
+				module,
+				funcname,
+				a,
+				b,
+				action,
+			)
+			return
+		}
+	})
+}
+
 func TestAdd(t *testing.T) {
 	module := "Graph"
 	funcname := "Add() >> Find()"
@@ -55,6 +171,10 @@ func TestAdd(t *testing.T) {
 			name:  "multi word input",
 			input: []string{"cat", "cot", "dot", "dog"},
 		},
+		{
+			name:  "word added after a longer word with it as prefix",
+			input: []string{"cats", "cat", "ca"},
+		},
 		{
 			name:  "zero-length input",
 			input: []string{},
@@ -97,17 +217,18 @@ func verifyByte(word string, node *Node) bool {
 		return true
 	}
 
-	n, ok := node.charMap[word[0]]
+	char, size := utf8.DecodeRuneInString(word)
+	n, ok := node.charMap[char]
 
 	if !ok {
 		return false
 	}
 
-	if n.Byte() != word[0] {
+	if n.Byte() != byte(char) {
 		return false
 	}
 
-	return verifyByte(word[1:], n)
+	return verifyByte(word[size:], n)
 }
 
 func FuzzByte(f *testing.F) {
@@ -119,8 +240,15 @@ func FuzzByte(f *testing.F) {
 	f.Add("cot")
 	f.Add("dot")
 	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
 
 	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
 		n := New()
 		n.Add(a)
 
@@ -142,17 +270,18 @@ func verifyString(word string, node *Node) bool {
 		return true
 	}
 
-	n, ok := node.charMap[word[0]]
+	char, size := utf8.DecodeRuneInString(word)
+	n, ok := node.charMap[char]
 
 	if !ok {
 		return false
 	}
 
-	if n.String() != string(word[0]) {
+	if n.String() != string(char) {
 		return false
 	}
 
-	return verifyByte(word[1:], n)
+	return verifyString(word[size:], n)
 }
 
 func FuzzString(f *testing.F) {
@@ -164,8 +293,15 @@ func FuzzString(f *testing.F) {
 	f.Add("cot")
 	f.Add("dot")
 	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
 
 	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
 		n := New()
 		n.Add(a)
 
@@ -187,17 +323,18 @@ func verifyRune(word string, node *Node) bool {
 		return true
 	}
 
-	n, ok := node.charMap[word[0]]
+	char, size := utf8.DecodeRuneInString(word)
+	n, ok := node.charMap[char]
 
 	if !ok {
 		return false
 	}
 
-	if n.Rune() != rune(word[0]) {
+	if n.Rune() != char {
 		return false
 	}
 
-	return verifyByte(word[1:], n)
+	return verifyRune(word[size:], n)
 }
 
 func FuzzRune(f *testing.F) {
@@ -209,8 +346,15 @@ func FuzzRune(f *testing.F) {
 	f.Add("cot")
 	f.Add("dot")
 	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
 
 	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
 		n := New()
 		n.Add(a)
 
@@ -291,8 +435,15 @@ func FuzzGetRoot(f *testing.F) {
 	f.Add("cot")
 	f.Add("dot")
 	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
 
 	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
 		n := New()
 		n.Add(a)
 
@@ -314,3 +465,328 @@ func FuzzGetRoot(f *testing.F) {
 		}
 	})
 }
+
+func TestRemove(t *testing.T) {
+	module := "Graph"
+	funcname := "Remove()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name    string
+		input   []string
+		remove  []string
+		wants   int
+		remains []string
+		nodes   int
+	}
+
+	var tests = []test{
+		{
+			name:    "single word",
+			input:   []string{"cat", "dog"},
+			remove:  []string{"cat"},
+			wants:   1,
+			remains: []string{"dog"},
+			nodes:   3,
+		},
+		{
+			name:    "word with a shared prefix",
+			input:   []string{"cat", "cot"},
+			remove:  []string{"cot"},
+			wants:   1,
+			remains: []string{"cat"},
+			nodes:   3,
+		},
+		{
+			name:    "prefix of another word",
+			input:   []string{"cat", "cats"},
+			remove:  []string{"cat"},
+			wants:   1,
+			remains: []string{"cats"},
+			nodes:   4,
+		},
+		{
+			name:    "word with a prefix in the dictionary",
+			input:   []string{"cat", "cats"},
+			remove:  []string{"cats"},
+			wants:   1,
+			remains: []string{"cat"},
+			nodes:   3,
+		},
+		{
+			name:    "repeated and missing words",
+			input:   []string{"cat", "cot", "dog"},
+			remove:  []string{"cat", "cat", "ca", "bat", ""},
+			wants:   1,
+			remains: []string{"cot", "dog"},
+			nodes:   6,
+		},
+		{
+			name:    "all words",
+			input:   []string{"cat", "cot", "dog"},
+			remove:  []string{"dog", "cot", "cat"},
+			wants:   3,
+			remains: []string{},
+			nodes:   0,
+		},
+		{
+			name:    "nil input",
+			input:   []string{"cat"},
+			wants:   0,
+			remains: []string{"cat"},
+			nodes:   3,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		n := New()
+		n.Add(test.input...)
+
+		if removed := n.Remove(test.remove...); removed != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				removed,
+				test.name,
+			)
+			return
+		}
+
+		if words := n.words(); !reflect.DeepEqual(words, test.remains) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] words mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.remains,
+				words,
+				test.name,
+			)
+			return
+		}
+
+		// branches which no longer lead to any word are pruned
+		if nodes := countNodes(n); nodes != test.nodes {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] nodes count mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.nodes,
+				nodes,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func FuzzWord(f *testing.F) {
+	module := "Graph"
+	funcname := "Word()"
+	action := "fuzz testing graph's Add(...string), then Word(), Depth() and Parent() on each node"
+
+	f.Add("cat")
+	f.Add("cot")
+	f.Add("dot")
+	f.Add("dog")
+	f.Add("café")
+	f.Add("straße")
+
+	f.Fuzz(func(t *testing.T, a string) {
+		// words which are not valid UTF-8 are not added to the graph
+		if !utf8.ValidString(a) {
+			t.Skip()
+		}
+
+		n := New()
+		n.Add(a)
+
+		chars := []rune(a)
+		nodes := n.GetNodes(a)
+
+		for idx, node := range nodes {
+			parent := n
+
+			if idx > 0 {
+				parent = nodes[idx-1]
+			}
+
+			if node.Word() != string(chars[:idx+1]) ||
+				node.Depth() != idx+1 ||
+				node.Parent() != parent ||
+				parent.Child(chars[idx]) != node ||
+				node.IsWord() != (idx == len(nodes)-1) {
+				t.Errorf(
+					"FAILED -- [%s] [%s] output mismatch error on node #%v: got %q at depth %v; input: %s -- action: %s",
+					module,
+					funcname,
+					idx,
+					node.Word(),
+					node.Depth(),
+					a,
+					action,
+				)
+				return
+			}
+		}
+	})
+}
+
+func TestTraversal(t *testing.T) {
+	module := "Graph"
+	funcname := "Children()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		path     string
+		children []string
+		word     string
+		isWord   bool
+		depth    int
+	}
+
+	root := New()
+	root.Add("dog", "cat", "cats", "car", "cot", "café")
+
+	var tests = []test{
+		{
+			name:     "root node",
+			path:     "",
+			children: []string{"c", "d"},
+			word:     "",
+			depth:    0,
+		},
+		{
+			name:     "prefix node",
+			path:     "ca",
+			children: []string{"f", "r", "t"},
+			word:     "ca",
+			depth:    2,
+		},
+		{
+			name:     "word which is a prefix of another word",
+			path:     "cat",
+			children: []string{"s"},
+			word:     "cat",
+			isWord:   true,
+			depth:    3,
+		},
+		{
+			name:     "multi-byte word",
+			path:     "café",
+			children: []string{},
+			word:     "café",
+			isWord:   true,
+			depth:    4,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		node := root
+
+		for _, char := range test.path {
+			node = node.Child(char)
+
+			if node == nil {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] missing child %q -- action: %s",
+					idx,
+					module,
+					"Child()",
+					char,
+					test.name,
+				)
+				return
+			}
+		}
+
+		children := []string{}
+
+		for _, child := range node.Children() {
+			children = append(children, child.String())
+		}
+
+		if !reflect.DeepEqual(children, test.children) ||
+			node.Word() != test.word ||
+			node.IsWord() != test.isWord ||
+			node.Depth() != test.depth {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v %q (%v) at depth %v ; got %v %q (%v) at depth %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.children,
+				test.word,
+				test.isWord,
+				test.depth,
+				children,
+				node.Word(),
+				node.IsWord(),
+				node.Depth(),
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// missing children and the root's parent
+	if root.Child('x') != nil || root.Child('c').Child('x') != nil || root.Parent() != nil {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] expected nil nodes -- action: %s",
+			len(tests),
+			module,
+			"Child()",
+			"missing children",
+		)
+	}
+}
+
+// countNodes function will return the number of nodes below the input node
+func countNodes(n *Node) int {
+	var count int
+
+	for _, child := range n.charMap {
+		count += 1 + countNodes(child)
+	}
+
+	return count
+}
+
+func TestNodeSize(t *testing.T) {
+	module := "Graph"
+	funcname := "Node"
+
+	// a dictionary holds one Node per character, so the state of the whole dictionary is kept behind a single
+	// pointer in the root node; leaving each Node with its charMap, char and isEnd, parent, dict and payload
+	word := unsafe.Sizeof(uintptr(0))
+	wants := 6 * word
+
+	if size := unsafe.Sizeof(Node{}); size > wants {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] node size mismatch error: wanted at most %v bytes ; got %v -- action: %s",
+			0,
+			module,
+			funcname,
+			wants,
+			size,
+			"size of a trie node",
+		)
+	}
+}
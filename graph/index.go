@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// index struct holds the indexes built over the whole dictionary, in the root node. Each index is built the first
+// time it is needed, and then kept up to date as words are added to (or removed from) the dictionary, by only
+// updating the neighborhoods of the changed words (see `added()` and `removed()`). It is safe for concurrent use,
+// although changing the dictionary while querying it is not.
+type index struct {
+	mu         sync.Mutex
+	adjacency  atomic.Value // *adjacency; read without holding the lock, as it is used on every sibling look-up
+	components *components
+}
+
+// added method will update the indexes (the ones already built) with the input words, once they are added to the
+// dictionary in the input root node. Words which were already in the dictionary are skipped. It is a no-op on a
+// nil index.
+func (i *index) added(root *Node, words ...string) {
+	if i == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	adj, _ := i.adjacency.Load().(*adjacency)
+
+	if adj == nil {
+		return
+	}
+
+	for _, w := range words {
+		if !root.has(w) {
+			continue
+		}
+
+		id, ok := adj.insert(root, w)
+
+		if ok && i.components != nil {
+			i.components.insert(id)
+		}
+	}
+}
+
+// removed method will update the indexes (the ones already built) once the input words are removed from the
+// dictionary. Words which were not in the dictionary are skipped. It is a no-op on a nil index.
+func (i *index) removed(words ...string) {
+	if i == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	adj, _ := i.adjacency.Load().(*adjacency)
+
+	if adj == nil {
+		return
+	}
+
+	for _, w := range words {
+		id, siblings, ok := adj.remove(w)
+
+		if ok && i.components != nil {
+			i.components.remove(id, siblings)
+		}
+	}
+}
+
+// words method will return all the words in the dictionary, sorted alphabetically (as the characters are sorted by
+// code point, which is also the byte order of their UTF-8 encoding, it is the same order as `sort.Strings()`)
+func (n *Node) words() []string {
+	words := []string{}
+
+	n.getRoot().rWords(nil, func(word string, _ *Node) {
+		words = append(words, word)
+	})
+
+	return words
+}
+
+// rWords method will recursively walk through the node's children (in alphabetical order), calling the input
+// function with each word found (and the node it ends in), where prefix holds the characters leading up to this node
+func (n *Node) rWords(prefix []rune, fn func(word string, node *Node)) {
+	for _, child := range n.Children() {
+		word := append(prefix[:len(prefix):len(prefix)], child.char)
+
+		if child.isEnd {
+			fn(string(word), child)
+		}
+
+		child.rWords(word, fn)
+	}
+}
@@ -0,0 +1,225 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestIndexUpdate(t *testing.T) {
+	module := "Index"
+	funcname := "Add() / Remove()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		words []string // initial dictionary
+		pool  []string // words to add or remove at random
+		seed  int64
+		steps int
+	}
+
+	var tests = []test{
+		{
+			name:  "dense dictionary",
+			words: combinations("abc", 3),
+			pool:  append(combinations("abcd", 3), combinations("abc", 2)...),
+			seed:  1,
+			steps: 200,
+		},
+		{
+			name:  "sparse dictionary",
+			words: []string{"cat", "cot", "dog", "dig", "cats", "pat"},
+			pool:  []string{"cat", "cot", "cog", "dog", "dig", "cats", "pat", "pad", "pads", "dots", "dot", "cots"},
+			seed:  2,
+			steps: 200,
+		},
+		{
+			name:  "from an empty dictionary",
+			pool:  combinations("xyz", 3),
+			seed:  3,
+			steps: 100,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		root := New()
+		root.Add(test.words...)
+		root.Build()
+
+		rnd := rand.New(rand.NewSource(test.seed))
+
+		for step := 0; step < test.steps; step++ {
+			w := test.pool[rnd.Intn(len(test.pool))]
+
+			if root.Find(w) {
+				root.Remove(w)
+			} else {
+				root.Add(w)
+			}
+
+			fresh := New()
+			fresh.Add(root.words()...)
+
+			if msg := sameIndex(root, fresh); msg != "" {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] index mismatch error after step #%v (%q): %s -- action: %s",
+					idx,
+					module,
+					funcname,
+					step,
+					w,
+					msg,
+					test.name,
+				)
+				return
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestIndexRemove(t *testing.T) {
+	module := "Index"
+	funcname := "Remove()"
+
+	_ = module
+	_ = funcname
+
+	root := New()
+	root.Add("cat", "cog", "cot", "dig", "dog")
+	root.Build()
+
+	// "cog" is the only connection between both halves
+	if !root.Reachable("cat", "dog") {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] words should be reachable before removing the bridge -- action: %s",
+			0,
+			module,
+			funcname,
+			"before removing a bridge",
+		)
+		return
+	}
+
+	if root.Remove("cog") != 1 || root.Remove("cog") != 0 {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] unexpected result removing words -- action: %s",
+			1,
+			module,
+			funcname,
+			"remove a word twice",
+		)
+		return
+	}
+
+	if root.Reachable("cat", "dog") || root.ComponentOf("cog") != -1 ||
+		root.ComponentSize(root.ComponentOf("cat")) != 2 || root.ComponentSize(root.ComponentOf("dog")) != 2 {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] stale component index after removing words -- action: %s",
+			2,
+			module,
+			funcname,
+			"after removing a bridge",
+		)
+		return
+	}
+
+	siblings, err := root.Siblings("cot")
+
+	if err != nil || !reflect.DeepEqual(siblings, []string{"cat"}) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] stale siblings after removing words: %v (%v) -- action: %s",
+			3,
+			module,
+			funcname,
+			siblings,
+			err,
+			"siblings after removing a bridge",
+		)
+		return
+	}
+
+	if _, err := root.FindRoute("cat", "dog"); err != ErrNoRoute {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] unexpected error: wanted %v ; got %v -- action: %s",
+			4,
+			module,
+			funcname,
+			ErrNoRoute,
+			err,
+			"route after removing a bridge",
+		)
+		return
+	}
+}
+
+// sameIndex function will compare the indexes of the input root nodes (one kept up to date, the other built from
+// scratch), returning a description of the first difference found; or an empty string if they match
+func sameIndex(updated, fresh *Node) string {
+	words := fresh.words()
+
+	if !reflect.DeepEqual(updated.words(), words) {
+		return "words differ"
+	}
+
+	// the component IDs may differ, but both must split the words the same way
+	ids := map[int]int{}
+
+	for _, w := range words {
+		want, _ := fresh.Fuzz(w)
+		got, _ := updated.Fuzz(w)
+
+		if !reflect.DeepEqual(got, want) {
+			return "siblings of " + w + " differ"
+		}
+
+		freshID := fresh.ComponentOf(w)
+		updatedID := updated.ComponentOf(w)
+
+		if id, ok := ids[updatedID]; ok && id != freshID || !ok && containsValue(ids, freshID) {
+			return "component of " + w + " differs"
+		}
+
+		ids[updatedID] = freshID
+
+		if updated.ComponentSize(updatedID) != fresh.ComponentSize(freshID) {
+			return "size of the component of " + w + " differs"
+		}
+	}
+
+	return ""
+}
+
+// containsValue function will return true if any key in the input map is mapped to the input value
+func containsValue(m map[int]int, value int) bool {
+	for _, v := range m {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func BenchmarkRemove(b *testing.B) {
+	root, _, _ := benchmarkDictionary(b)
+
+	// "aaaaz" is only connected to "aaaa", so removing "aaaa" splits it off the (large) component of the
+	// remaining words
+	root.Add("aaaa", "aaaaz")
+	root.Build()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		root.Remove("aaaa")
+		root.Add("aaaa")
+	}
+}
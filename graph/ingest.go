@@ -1,16 +1,21 @@
 package graph
 
 import (
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 )
 
 const delimiter byte = 10 // bytes for newline (\n)
+const separator byte = 9  // bytes for tab (\t), between a word and its count in a frequency list
 const dwylEnglishWordsRepo = `https://raw.githubusercontent.com/dwyl/english-words/master/words_alpha.txt`
 
 // FromWordList function will get a list of strings from the file retrieved from the path
-// provided. It separates words by newlines.
+// provided. It separates words by newlines. Frequency lists (see FromFrequencyList()) are accepted
+// too, in which case only the words are kept.
 func FromWordList(path string) ([]string, error) {
 	if path == "" {
 		return FromOnlineSource(dwylEnglishWordsRepo)
@@ -51,17 +56,23 @@ func FromOnlineSource(pageURL string) ([]string, error) {
 }
 
 // fromBytes function is a converter to materialize raw data (a slice of bytes)
-// into a list of strings (separated by newlines, or byte 10)
+// into a list of strings (separated by newlines, or byte 10). Anything after a tab
+// (byte 9) in a line is dropped, as it is a word's count in a frequency list.
 func fromBytes(b []byte) []string {
 	var out []string
 	var word []byte
+	var skip bool
 
 	for _, u := range b {
-		if u != delimiter {
-			word = append(word, u)
-		} else {
+		switch {
+		case u == delimiter:
 			out = append(out, string(word))
 			word = []byte{}
+			skip = false
+		case u == separator:
+			skip = true
+		case !skip:
+			word = append(word, u)
 		}
 	}
 
@@ -71,3 +82,54 @@ func fromBytes(b []byte) []string {
 
 	return out
 }
+
+// FromFrequencyList function will get the Frequencies from the file retrieved from the path
+// provided, where each line holds a word and its count (such as its number of occurrences in a
+// corpus), separated by a tab:
+//
+//	the	23135851162
+//	of	13151942776
+//
+// Empty lines are skipped. A line without a tab, or with a count which is not a non-negative
+// integer, is reported as an error wrapping ErrInvalidFormat. If a word shows up more than once,
+// its counts are added up.
+func FromFrequencyList(path string) (Frequencies, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFrequencies(b)
+}
+
+// parseFrequencies function will materialize raw data (a slice of bytes) in the frequency list
+// format into Frequencies
+func parseFrequencies(b []byte) (Frequencies, error) {
+	freq := Frequencies{}
+
+	for idx, line := range strings.Split(string(b), string(delimiter)) {
+		// tolerate files with CRLF line endings
+		line = strings.TrimSuffix(line, "\r")
+
+		if len(line) == 0 {
+			continue
+		}
+
+		sep := strings.LastIndexByte(line, separator)
+
+		if sep < 1 {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrInvalidFormat, idx+1, line)
+		}
+
+		count, err := strconv.Atoi(strings.TrimSpace(line[sep+1:]))
+
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrInvalidFormat, idx+1, line)
+		}
+
+		freq[line[:sep]] += count
+	}
+
+	return freq, nil
+}
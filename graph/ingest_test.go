@@ -1,7 +1,11 @@
 package graph
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -64,3 +68,129 @@ func TestFromWordList(t *testing.T) {
 	}
 
 }
+
+func TestFromFrequencyList(t *testing.T) {
+	module := "Graph"
+	funcname := "FromFrequencyList()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		input string
+		wants Frequencies
+		err   error
+	}
+
+	var tests = []test{
+		{
+			name:  "valid list",
+			input: "the\t5000\ncat\t120\naalii\t0\n",
+			wants: Frequencies{"the": 5000, "cat": 120, "aalii": 0},
+		},
+		{
+			name:  "CRLF line endings and empty lines",
+			input: "the\t5000\r\n\r\ncat\t120\r\n",
+			wants: Frequencies{"the": 5000, "cat": 120},
+		},
+		{
+			name:  "duplicate words are added up",
+			input: "cat\t120\ndog\t90\ncat\t30",
+			wants: Frequencies{"cat": 150, "dog": 90},
+		},
+		{
+			name:  "empty list",
+			input: "",
+			wants: Frequencies{},
+		},
+		{
+			name:  "missing count",
+			input: "the\t5000\ncat\n",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "missing word",
+			input: "\t5000\n",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "invalid count",
+			input: "the\tmany\n",
+			err:   ErrInvalidFormat,
+		},
+		{
+			name:  "negative count",
+			input: "the\t-1\n",
+			err:   ErrInvalidFormat,
+		},
+	}
+
+	dir := t.TempDir()
+
+	var verify = func(idx int, test test) {
+		path := filepath.Join(dir, fmt.Sprintf("list-%d.txt", idx))
+
+		if err := os.WriteFile(path, []byte(test.input), 0o600); err != nil {
+			t.Fatalf("unable to write test file: %v", err)
+		}
+
+		freq, err := FromFrequencyList(path)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(freq, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				freq,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// missing files are not looked up online
+	if _, err := FromFrequencyList(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] expected an error reading a missing file -- action: %s",
+			len(tests),
+			module,
+			funcname,
+			"missing file",
+		)
+	}
+
+	// word lists accept the frequency list format too
+	if words := fromBytes([]byte("the\t5000\ncat\t120\ndog\n")); !reflect.DeepEqual(words, []string{"the", "cat", "dog"}) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+			len(tests)+1,
+			module,
+			"fromBytes()",
+			[]string{"the", "cat", "dog"},
+			words,
+			"word list in the frequency list format",
+		)
+	}
+}
@@ -0,0 +1,198 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FindRoutes method will take an origin and target words, and return up to k distinct routes from one word to
+// the other (with single-character changes), ordered from the shortest to the longest.
+//
+// Routes are loop-free (no word shows up twice in the same route), and are looked up with Yen's algorithm over
+// the same neighbor relationship as `Fuzz()`: starting from the shortest route, each following one is the shortest
+// detour from a route found before it. On a tie, routes are ordered alphabetically (word by word), so the same
+// query against the same dictionary will always return the same routes. Values of k below 1 are treated as 1.
+//
+// The query can be tuned with Options, such as its time limit (WithTimeout()) or the maximum length of the
+// routes (WithMaxDepth()). If there is no route between both words, ErrNoRoute is returned. If the query
+// expires before it is complete, a TimeoutError (matching ErrTimeout) is returned, along with the routes found
+// until then.
+//
+// The Constraints set with WithConstraints() are honored, except for waypoints: as the routes are detours from one
+// another, they cannot be kept going through the waypoints; so ErrUnsupported is returned if any are set.
+func (n *Node) FindRoutes(origin, target string, k int, opts ...Option) ([][]string, error) {
+	cfg := newConfig(opts...)
+
+	if cfg.rules != nil && len(cfg.rules.waypoints) > 0 {
+		return nil, fmt.Errorf("%w: waypoints in %s()", ErrUnsupported, opFindRoutes)
+	}
+
+	// routes are looked up by length, with breadth-first searches
+	if err := cfg.unsupported(BreadthFirst, opFindRoutes); err != nil {
+		return nil, err
+	}
+
+	if cfg.stats == nil {
+		cfg.stats = &Stats{}
+	}
+
+	origin, target, err := n.validate(origin, target, opFindRoutes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cfg = n.normalizeRules(cfg)
+
+	// words in different components cannot be connected
+	if !n.components().reachable(origin, target) {
+		return nil, ErrNoRoute
+	}
+
+	if k < 1 {
+		k = 1
+	}
+
+	// set the time limit for this query
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+
+	routes, err := n.kShortest(ctx, cfg, origin, target, k)
+
+	if err != nil && ctx.Err() != nil {
+		var partial []string
+		if len(routes) > 0 {
+			partial = routes[0]
+		}
+
+		return routes, &TimeoutError{Route: partial, Err: err}
+	}
+
+	return routes, err
+}
+
+// kShortest method will look up the k shortest loop-free routes from the origin to the target, with Yen's
+// algorithm.
+//
+// The first route is the shortest one, from a breadth-first search. Then, for each word in the last route found
+// (the spur word), a new breadth-first search is done from it to the target, which:
+//   - cannot go through the words before the spur word in the route (which keeps the routes loop-free);
+//   - cannot take the step after the spur word that any route found so far (with the same beginning) took.
+//
+// Joining the beginning of the route with this detour gives a candidate route. Out of all candidates, the shortest
+// one is the next route; until k routes are found or there are no candidates left.
+func (n *Node) kShortest(ctx context.Context, cfg *config, origin, target string, k int) ([][]string, error) {
+	first, err := n.shortest(ctx, cfg, origin, target, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	routes := [][]string{first}
+	candidates := [][]string{}
+	seen := map[string]struct{}{routeKey(first): {}}
+
+	for len(routes) < k {
+		last := routes[len(routes)-1]
+
+		for i := 0; i < len(last)-1; i++ {
+			spur := last[i]
+			base := last[:i+1]
+
+			// rule out the words in the beginning of the route (except for the spur word)
+			blockedWords := map[string]struct{}{}
+			for _, w := range base[:i] {
+				blockedWords[w] = struct{}{}
+			}
+
+			// rule out the steps taken from the spur word by routes with the same beginning
+			blockedSteps := map[string]struct{}{}
+			for _, r := range routes {
+				if len(r) > i+1 && equalRoutes(r[:i+1], base) {
+					blockedSteps[r[i+1]] = struct{}{}
+				}
+			}
+
+			skip := func(from, to string) bool {
+				if _, ok := blockedWords[to]; ok {
+					return true
+				}
+
+				if from == spur {
+					_, ok := blockedSteps[to]
+					return ok
+				}
+
+				return false
+			}
+
+			detour, err := n.shortest(ctx, cfg, spur, target, skip)
+
+			if err != nil {
+				// halt the query once the context is done
+				if ctx.Err() != nil {
+					return routes, err
+				}
+
+				continue
+			}
+
+			candidate := make([]string, 0, i+len(detour))
+			candidate = append(candidate, base[:i]...)
+			candidate = append(candidate, detour...)
+
+			// skip candidates past the maximum depth, or which were already found
+			if cfg.maxDepth > 0 && len(candidate) > cfg.maxDepth {
+				continue
+			}
+
+			key := routeKey(candidate)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			candidates = append(candidates, candidate)
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		// the shortest candidate is the next route
+		sort.SliceStable(candidates, func(a, b int) bool {
+			if len(candidates[a]) != len(candidates[b]) {
+				return len(candidates[a]) < len(candidates[b])
+			}
+
+			return routeKey(candidates[a]) < routeKey(candidates[b])
+		})
+
+		routes = append(routes, candidates[0])
+		candidates = candidates[1:]
+	}
+
+	return routes, nil
+}
+
+// routeKey function will return a string representation of a route, to be used as a map key or for sorting
+func routeKey(route []string) string {
+	return strings.Join(route, "\x00")
+}
+
+// equalRoutes function will return true if both routes have the same words in the same order
+func equalRoutes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFindRoutes(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoutes()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		origin string
+		target string
+		k      int
+		opts   []Option
+		wants  [][]string
+		err    error
+	}
+
+	root := New()
+	root.Add("cat", "cot", "cog", "dog", "dot", "cag", "rabi", "rare")
+
+	var tests = []test{
+		{
+			name:   "all routes with the same length",
+			origin: "cat",
+			target: "dog",
+			k:      3,
+			wants: [][]string{
+				{"cat", "cag", "cog", "dog"},
+				{"cat", "cot", "cog", "dog"},
+				{"cat", "cot", "dot", "dog"},
+			},
+		},
+		{
+			name:   "k larger than the number of existing routes",
+			origin: "cat",
+			target: "dog",
+			k:      5,
+			wants: [][]string{
+				{"cat", "cag", "cog", "dog"},
+				{"cat", "cot", "cog", "dog"},
+				{"cat", "cot", "dot", "dog"},
+				{"cat", "cag", "cog", "cot", "dot", "dog"},
+			},
+		},
+		{
+			name:   "k below 1",
+			origin: "cat",
+			target: "dog",
+			k:      0,
+			wants: [][]string{
+				{"cat", "cag", "cog", "dog"},
+			},
+		},
+		{
+			name:   "forbidden words are avoided",
+			origin: "cat",
+			target: "dog",
+			k:      3,
+			opts:   []Option{WithConstraints(Constraints{Forbidden: []string{"cag"}})},
+			wants: [][]string{
+				{"cat", "cot", "cog", "dog"},
+				{"cat", "cot", "dot", "dog"},
+			},
+		},
+		{
+			name:   "invalid call -- waypoints are not supported",
+			origin: "cat",
+			target: "dog",
+			k:      2,
+			opts:   []Option{WithConstraints(Constraints{Waypoints: []string{"cog"}})},
+			err:    ErrUnsupported,
+		},
+		{
+			name:   "invalid call -- origin is same as target",
+			origin: "cat",
+			target: "cat",
+			k:      2,
+			err:    ErrSameWord,
+		},
+		{
+			name:   "invalid call -- target not in graph",
+			origin: "cat",
+			target: "cut",
+			k:      2,
+			err:    ErrNonExistent,
+		},
+		{
+			name:   "zero routes found",
+			origin: "cat",
+			target: "rare",
+			k:      2,
+			err:    ErrNoRoute,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		routes, err := root.FindRoutes(test.origin, test.target, test.k, test.opts...)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(routes, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				routes,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
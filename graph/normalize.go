@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalizer type is a step in the normalization of the words in a dictionary: it takes a word and returns its
+// normalized form, or false if the word is rejected.
+//
+// A dictionary is created with its Normalizer (see New()), which is applied the same way to the words added to
+// (or removed from) it, and to the words in all queries against it, such as Find(), Siblings() or FindRoute(); so
+// that "Cat", "cat " and "cat\r" all refer to the word "cat". Rejected words are never added to the dictionary,
+// and are reported as non-existent by queries.
+//
+// Normalizers are expected to be idempotent (normalizing a word twice yields the same word as doing it once). The
+// lower-level methods which take words as they are stored in the dictionary, such as Fuzz() or GetNodes(), do not
+// apply it.
+type Normalizer func(word string) (string, bool)
+
+// Pipeline function will chain the input Normalizers into a single one, applying them in order; a word rejected by
+// any of them is rejected by the pipeline.
+func Pipeline(normalizers ...Normalizer) Normalizer {
+	return func(word string) (string, bool) {
+		var ok bool
+
+		for _, normalize := range normalizers {
+			if word, ok = normalize(word); !ok {
+				return "", false
+			}
+		}
+
+		return word, true
+	}
+}
+
+// TrimSpace function is a Normalizer which removes the leading and trailing white space in a word (including
+// carriage returns left over from files with CRLF line endings). It rejects words made only of white space.
+func TrimSpace(word string) (string, bool) {
+	word = strings.TrimSpace(word)
+
+	return word, len(word) > 0
+}
+
+// FoldCase function is a Normalizer which applies Unicode case folding to a word, so that words differing only by
+// case are the same word; for example, "Cat" and "CAT" are folded into "cat", and "Straße" into "strasse".
+func FoldCase(word string) (string, bool) {
+	return cases.Fold().String(word), true
+}
+
+// NFC function is a Normalizer which converts a word into its Unicode Normalization Form C, so that the same
+// characters are always encoded in the same way; for example, "e" followed by a combining acute accent is
+// composed into "é".
+func NFC(word string) (string, bool) {
+	return norm.NFC.String(word), true
+}
+
+// StripAccents function is a Normalizer which removes the diacritical marks from the characters in a word, such as
+// "café" into "cafe" or "pão" into "pao". The resulting word is in Normalization Form C.
+func StripAccents(word string) (string, bool) {
+	// decompose the characters, drop the (non-spacing) marks and compose them back; transformers are not safe for
+	// concurrent use, so a new chain is created on each call
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	out, _, err := transform.String(t, word)
+
+	if err != nil {
+		return "", false
+	}
+
+	return out, true
+}
+
+// AllowOnly function will return a Normalizer which rejects the words with any character outside of the input
+// Unicode range tables, such as unicode.Latin or unicode.Letter. Words which are allowed are not changed.
+func AllowOnly(tables ...*unicode.RangeTable) Normalizer {
+	return func(word string) (string, bool) {
+		for _, char := range word {
+			if !unicode.IsOneOf(tables, char) {
+				return "", false
+			}
+		}
+
+		return word, true
+	}
+}
+
+// normalize method will apply the dictionary's Normalizer to the input word, returning false if the word is
+// rejected. Without a Normalizer, words are used verbatim.
+func (n *Node) normalize(word string) (string, bool) {
+	normalizer := n.state().normalizer
+
+	if normalizer == nil {
+		return word, true
+	}
+
+	return normalizer(word)
+}
+
+// normalizeRules method will return a copy of the config with its constraints normalized like the words in the
+// queries, so that forbidden words and waypoints refer to the words as stored in the dictionary. Rejected
+// forbidden words are dropped (as they cannot show up in a route), while rejected waypoints are kept verbatim (so
+// that they are reported as non-existent).
+func (n *Node) normalizeRules(cfg *config) *config {
+	if cfg.rules == nil || cfg.rules.normalized || n.state().normalizer == nil {
+		return cfg
+	}
+
+	r := &rules{
+		blocked:     make(map[string]struct{}, len(cfg.rules.blocked)),
+		waypoints:   make([]string, 0, len(cfg.rules.waypoints)),
+		fixedLength: cfg.rules.fixedLength,
+		normalized:  true,
+	}
+
+	for w := range cfg.rules.blocked {
+		if word, ok := n.normalize(w); ok {
+			r.blocked[word] = struct{}{}
+		}
+	}
+
+	for _, w := range cfg.rules.waypoints {
+		if word, ok := n.normalize(w); ok {
+			w = word
+		}
+
+		r.waypoints = append(r.waypoints, w)
+	}
+
+	cp := *cfg
+	cp.rules = r
+
+	return &cp
+}
@@ -0,0 +1,296 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestNormalizer(t *testing.T) {
+	module := "Normalizer"
+	funcname := "Pipeline()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name       string
+		normalizer Normalizer
+		input      string
+		wants      string
+		ok         bool
+	}
+
+	var tests = []test{
+		{
+			name:       "trim white space",
+			normalizer: TrimSpace,
+			input:      " cat\r\n",
+			wants:      "cat",
+			ok:         true,
+		},
+		{
+			name:       "reject white space",
+			normalizer: TrimSpace,
+			input:      " \t\r",
+		},
+		{
+			name:       "fold case",
+			normalizer: FoldCase,
+			input:      "Straße",
+			wants:      "strasse",
+			ok:         true,
+		},
+		{
+			name:       "compose characters",
+			normalizer: NFC,
+			input:      "cafe\u0301",
+			wants:      "café",
+			ok:         true,
+		},
+		{
+			name:       "strip accents",
+			normalizer: StripAccents,
+			input:      "pão",
+			wants:      "pao",
+			ok:         true,
+		},
+		{
+			name:       "strip combining accents",
+			normalizer: StripAccents,
+			input:      "cafe\u0301",
+			wants:      "cafe",
+			ok:         true,
+		},
+		{
+			name:       "allowed characters",
+			normalizer: AllowOnly(unicode.Latin),
+			input:      "straße",
+			wants:      "straße",
+			ok:         true,
+		},
+		{
+			name:       "disallowed characters",
+			normalizer: AllowOnly(unicode.Latin),
+			input:      "c4t",
+		},
+		{
+			name:       "pipeline",
+			normalizer: Pipeline(TrimSpace, NFC, FoldCase),
+			input:      " CAFE\u0301\r",
+			wants:      "café",
+			ok:         true,
+		},
+		{
+			name:       "pipeline allowing a word once it is trimmed",
+			normalizer: Pipeline(TrimSpace, AllowOnly(unicode.Letter)),
+			input:      "cat \r",
+			wants:      "cat",
+			ok:         true,
+		},
+		{
+			name:       "empty pipeline",
+			normalizer: Pipeline(),
+			input:      "Cat",
+			wants:      "Cat",
+			ok:         true,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		word, ok := test.normalizer(test.input)
+
+		if ok != test.ok || word != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %q (%v) ; got %q (%v) -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				test.ok,
+				word,
+				ok,
+				test.name,
+			)
+			return
+		}
+
+		// normalizers are idempotent
+		if ok {
+			if again, _ := test.normalizer(word); again != word {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] normalizer is not idempotent: wanted %q ; got %q -- action: %s",
+					idx,
+					module,
+					funcname,
+					word,
+					again,
+					test.name,
+				)
+				return
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestNormalizedDictionary(t *testing.T) {
+	module := "Graph"
+	funcname := "New(...Normalizer)"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		query func(root *Node) (interface{}, error)
+		wants interface{}
+		err   error
+	}
+
+	root := New(TrimSpace, NFC, FoldCase, AllowOnly(unicode.Letter))
+	root.Add("Cat", "cat ", "cot\r", "COG", "dog\r\n", "cafe\u0301", "c4t", " ")
+
+	var tests = []test{
+		{
+			name: "stored words",
+			query: func(root *Node) (interface{}, error) {
+				return root.words(), nil
+			},
+			wants: []string{"café", "cat", "cog", "cot", "dog"},
+		},
+		{
+			name: "find a word with different case and white space",
+			query: func(root *Node) (interface{}, error) {
+				return root.Find(" CAT\r"), nil
+			},
+			wants: true,
+		},
+		{
+			name: "find a decomposed word",
+			query: func(root *Node) (interface{}, error) {
+				return root.Find("CAFE\u0301"), nil
+			},
+			wants: true,
+		},
+		{
+			name: "find a rejected word",
+			query: func(root *Node) (interface{}, error) {
+				return root.Find("c4t"), nil
+			},
+			wants: false,
+		},
+		{
+			name: "siblings",
+			query: func(root *Node) (interface{}, error) {
+				return root.Siblings("Cot")
+			},
+			wants: []string{"cat", "cog"},
+		},
+		{
+			name: "siblings of a rejected word",
+			query: func(root *Node) (interface{}, error) {
+				return root.Siblings("c0t")
+			},
+			err: ErrNonExistent,
+		},
+		{
+			name: "route",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("Cat", "DOG\r", WithStrategy(BreadthFirst))
+			},
+			wants: []string{"cat", "cot", "cog", "dog"},
+		},
+		{
+			name: "route with normalized constraints",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("CAT", "dog", WithStrategy(BreadthFirst), WithConstraints(Constraints{
+					Forbidden: []string{"Cog"},
+				}))
+			},
+			err: ErrNoRoute,
+		},
+		{
+			name: "routes with normalized constraints",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoutes("cat", "dog", 2, WithConstraints(Constraints{
+					Forbidden: []string{"COT"},
+				}))
+			},
+			err: ErrNoRoute,
+		},
+		{
+			name: "route between the same normalized word",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("Cat", "cat")
+			},
+			err: ErrSameWord,
+		},
+		{
+			name: "route from a rejected word",
+			query: func(root *Node) (interface{}, error) {
+				return root.FindRoute("c4t", "dog")
+			},
+			err: ErrNonExistent,
+		},
+		{
+			name: "reachable",
+			query: func(root *Node) (interface{}, error) {
+				return root.Reachable("CAT", "Dog"), nil
+			},
+			wants: true,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		out, err := test.query(root)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(out, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				out,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+
+	// removing a word is normalized too
+	if removed := root.Remove(" COT "); removed != 1 || root.Find("cot") {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] unable to remove a normalized word: removed %v -- action: %s",
+			len(tests),
+			module,
+			"Remove()",
+			removed,
+			"remove a word with different case and white space",
+		)
+	}
+}
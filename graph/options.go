@@ -0,0 +1,206 @@
+package graph
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+	"unicode/utf8"
+)
+
+// Option type is a function that tunes a route query, such as the strategy it uses or its time limits.
+//
+// The package constants (maxQueryTime, maxNoResponseTime, maxRoutes) serve as the defaults
+// for a query, and can be overridden per call, for example:
+//
+//	route, err := n.FindRoute("cat", "dog", WithMaxDepth(8), WithMaxRoutes(20), WithTimeout(time.Second))
+type Option func(*config)
+
+// config struct holds the settings for a route query, as set by the provided Options
+type config struct {
+	strategy    Strategy
+	strategySet bool // set if the Strategy was picked with WithStrategy(), rather than left as the default
+	maxDepth    int
+	maxRoutes   int
+	timeout     time.Duration
+	noResponse  time.Duration
+	workers     int
+	stats       *Stats
+	rules       *rules
+	costs       Costs
+	rarity      int            // cost added per order of magnitude a word is rarer than the most common one
+	onRoute     func([]string) // called with each route found by the Weighted strategy, as it is found
+	originDepth int            // the Weighted strategy's default depth, set from the query's origin (see `withOrigin()`)
+}
+
+// newConfig function will create a config with the default settings, and apply the input Options to it
+func newConfig(opts ...Option) *config {
+	cfg := &config{
+		strategy:   Weighted,
+		maxRoutes:  maxRoutes,
+		timeout:    maxQueryTime,
+		noResponse: maxNoResponseTime,
+		workers:    runtime.NumCPU(),
+		costs:      defaultCosts,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+
+	// unknown strategies fall back to the default (Weighted) one, along with its default depth
+	switch cfg.strategy {
+	case Weighted, BreadthFirst, Bidirectional, AStar, Dijkstra:
+	default:
+		cfg.strategy = Weighted
+	}
+
+	// rare words are only penalized by the Dijkstra strategy, which is picked unless another one was
+	if cfg.rarity > 0 && !cfg.strategySet {
+		cfg.strategy = Dijkstra
+	}
+
+	return cfg
+}
+
+// unsupported method will return an error (matching ErrUnsupported) if the config has Options which a query (for
+// the input operation) cannot honor with the input Strategy, such as a rarity cost (see WithRarityCost()) outside
+// of the Dijkstra strategy; or nil otherwise.
+func (c *config) unsupported(strategy Strategy, op string) error {
+	if c.rarity > 0 && strategy != Dijkstra {
+		return fmt.Errorf("%w: rarity cost in %s() without the Dijkstra strategy", ErrUnsupported, op)
+	}
+
+	return nil
+}
+
+// withOrigin method will return a copy of the config for a route query from the input origin word, setting the
+// Weighted strategy's default depth from it: 3x the size of the origin. It is computed once per query, so that it
+// doesn't shrink as the route goes through shorter words.
+func (c *config) withOrigin(origin string) *config {
+	cp := *c
+	cp.originDepth = utf8.RuneCountInString(origin) * 3
+
+	return &cp
+}
+
+// depth method will return the maximum number of words in a route, or zero if it is unbounded.
+//
+// If it wasn't set with WithMaxDepth(), the Weighted strategy's default hard-limit is returned: 3x the
+// size of the query's origin; so, a route from "cat" to "dog" cannot have more than 9 words. The remaining
+// strategies are unbounded by default.
+func (c *config) depth() int {
+	if c.maxDepth > 0 {
+		return c.maxDepth
+	}
+
+	if c.strategy == Weighted {
+		return c.originDepth
+	}
+
+	return 0
+}
+
+// WithStrategy function will set the Strategy used to look up the route. By default, the Weighted strategy
+// is used; and so it is for unknown strategies.
+func WithStrategy(strategy Strategy) Option {
+	return func(c *config) {
+		c.strategy = strategy
+		c.strategySet = true
+	}
+}
+
+// WithMaxDepth function will set the maximum number of words in a route (including the origin and the
+// target). By default, the Weighted strategy limits its routes to 3x the length of the origin word, while
+// the remaining strategies are unbounded. Values below 2 are ignored.
+func WithMaxDepth(depth int) Option {
+	return func(c *config) {
+		if depth >= 2 {
+			c.maxDepth = depth
+		}
+	}
+}
+
+// WithMaxRoutes function will set the maximum number of routes the Weighted strategy accumulates before
+// returning the shortest one. Non-positive values are ignored.
+func WithMaxRoutes(routes int) Option {
+	return func(c *config) {
+		if routes > 0 {
+			c.maxRoutes = routes
+		}
+	}
+}
+
+// WithTimeout function will set the time limit for a route query. Non-positive values are ignored.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// WithNoResponseTimeout function will set how long the Weighted strategy waits for a new route, once it has
+// found one, before returning the shortest route. Non-positive values are ignored.
+func WithNoResponseTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		if timeout > 0 {
+			c.noResponse = timeout
+		}
+	}
+}
+
+// WithWorkers function will set the number of goroutines the Weighted strategy uses to explore routes
+// concurrently. By default, there is one worker per CPU. Non-positive values are ignored.
+func WithWorkers(workers int) Option {
+	return func(c *config) {
+		if workers > 0 {
+			c.workers = workers
+		}
+	}
+}
+
+// WithStats function will populate the input Stats with metrics on the route query, such as the number
+// of words expanded while looking up the route.
+func WithStats(stats *Stats) Option {
+	return func(c *config) {
+		c.stats = stats
+	}
+}
+
+// WithCosts function will set the cost of each type of change between two words, for the Dijkstra strategy
+// to look up the cheapest route. By default, all changes cost 1. Non-positive costs are ignored, keeping the
+// default for that type of change.
+func WithCosts(costs Costs) Option {
+	return func(c *config) {
+		if costs.Substitute > 0 {
+			c.costs.Substitute = costs.Substitute
+		}
+
+		if costs.Expand > 0 {
+			c.costs.Expand = costs.Expand
+		}
+
+		if costs.Reduce > 0 {
+			c.costs.Reduce = costs.Reduce
+		}
+	}
+}
+
+// WithRarityCost function will make the Dijkstra strategy penalize rare words, based on the dictionary's
+// Frequencies (see SetFrequencies()): on top of the cost of the change, each step costs the input value for each
+// order of magnitude the count of the word it leads to is below the highest count. For example, with a cost of 1
+// and a highest count of 5000, a step to a word with a count of 120 costs 2 more, and one to a word without a count
+// costs 4 more. By default, or if the dictionary has no Frequencies, rare words are not penalized. Non-positive
+// values are ignored.
+//
+// Unless another Strategy is set with WithStrategy(), this option picks the Dijkstra strategy. Queries which cannot
+// honor it, such as FindRoute() with another Strategy, FindRoutes() or FindRoutesBatch(), return ErrUnsupported.
+func WithRarityCost(cost int) Option {
+	return func(c *config) {
+		if cost > 0 {
+			c.rarity = cost
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestNewConfig(t *testing.T) {
+	module := "Options"
+	funcname := "newConfig()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		opts  []Option
+		wants *config
+	}
+
+	stats := &Stats{}
+
+	var tests = []test{
+		{
+			name: "default config",
+			wants: &config{
+				strategy:   Weighted,
+				maxRoutes:  maxRoutes,
+				timeout:    maxQueryTime,
+				noResponse: maxNoResponseTime,
+				workers:    runtime.NumCPU(),
+				costs:      defaultCosts,
+			},
+		},
+		{
+			name: "all options set",
+			opts: []Option{
+				WithStrategy(AStar),
+				WithMaxDepth(8),
+				WithMaxRoutes(20),
+				WithTimeout(time.Second),
+				WithNoResponseTimeout(time.Millisecond),
+				WithWorkers(2),
+				WithStats(stats),
+				WithCosts(Costs{Substitute: 1, Expand: 2, Reduce: 3}),
+			},
+			wants: &config{
+				strategy:    AStar,
+				strategySet: true,
+				maxDepth:    8,
+				maxRoutes:   20,
+				timeout:     time.Second,
+				noResponse:  time.Millisecond,
+				workers:     2,
+				stats:       stats,
+				costs:       Costs{Substitute: 1, Expand: 2, Reduce: 3},
+			},
+		},
+		{
+			name: "invalid values are ignored",
+			opts: []Option{
+				nil,
+				WithMaxDepth(1),
+				WithMaxRoutes(0),
+				WithTimeout(-time.Second),
+				WithNoResponseTimeout(0),
+				WithWorkers(0),
+				WithCosts(Costs{Substitute: -1}),
+				WithRarityCost(0),
+			},
+			wants: &config{
+				strategy:   Weighted,
+				maxRoutes:  maxRoutes,
+				timeout:    maxQueryTime,
+				noResponse: maxNoResponseTime,
+				workers:    runtime.NumCPU(),
+				costs:      defaultCosts,
+			},
+		},
+		{
+			name: "unknown strategy falls back to Weighted",
+			opts: []Option{WithStrategy(Strategy(99))},
+			wants: &config{
+				strategy:    Weighted,
+				strategySet: true,
+				maxRoutes:   maxRoutes,
+				timeout:     maxQueryTime,
+				noResponse:  maxNoResponseTime,
+				workers:     runtime.NumCPU(),
+				costs:       defaultCosts,
+			},
+		},
+		{
+			name: "rarity cost picks the Dijkstra strategy",
+			opts: []Option{WithRarityCost(2)},
+			wants: &config{
+				strategy:   Dijkstra,
+				maxRoutes:  maxRoutes,
+				timeout:    maxQueryTime,
+				noResponse: maxNoResponseTime,
+				workers:    runtime.NumCPU(),
+				costs:      defaultCosts,
+				rarity:     2,
+			},
+		},
+		{
+			name: "rarity cost keeps the strategy set with WithStrategy()",
+			opts: []Option{WithRarityCost(2), WithStrategy(BreadthFirst)},
+			wants: &config{
+				strategy:    BreadthFirst,
+				strategySet: true,
+				maxRoutes:   maxRoutes,
+				timeout:     maxQueryTime,
+				noResponse:  maxNoResponseTime,
+				workers:     runtime.NumCPU(),
+				costs:       defaultCosts,
+				rarity:      2,
+			},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		cfg := newConfig(test.opts...)
+
+		if !reflect.DeepEqual(cfg, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				cfg,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteOptions(t *testing.T) {
+	module := "Options"
+	funcname := "FindRoute(...Option)"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name    string
+		origin  string
+		target  string
+		opts    []Option
+		wants   []string
+		err     error
+		maxTime time.Duration
+	}
+
+	root := New()
+	root.Add("ruby", "rudy", "tubb", "tuby", "rubb", "rudd", "mudd", "muda", "bare", "rare", "rabi")
+
+	// the only route from "abcd" to "xy" goes through a single-character word
+	root.Add("abcd", "abc", "ab", "a", "x", "xy")
+
+	// the only route from "pq" to "vw" has 7 words, beyond the Weighted strategy's default depth for "pq"
+	root.Add("pq", "rq", "rs", "ts", "tu", "vu", "vw")
+
+	var tests = []test{
+		{
+			name:   "max depth fits the shortest route",
+			origin: "ruby",
+			target: "muda",
+			opts:   []Option{WithStrategy(BreadthFirst), WithMaxDepth(5)},
+			wants:  []string{"ruby", "rudy", "rudd", "mudd", "muda"},
+		},
+		{
+			name:   "max depth below the shortest route -- breadth-first",
+			origin: "ruby",
+			target: "muda",
+			opts:   []Option{WithStrategy(BreadthFirst), WithMaxDepth(4)},
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "max depth below the shortest route -- bidirectional",
+			origin: "ruby",
+			target: "muda",
+			opts:   []Option{WithStrategy(Bidirectional), WithMaxDepth(4)},
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "max depth below the shortest route -- A*",
+			origin: "ruby",
+			target: "muda",
+			opts:   []Option{WithStrategy(AStar), WithMaxDepth(4)},
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "default depth is set from the origin -- weighted",
+			origin: "abcd",
+			target: "xy",
+			wants:  []string{"abcd", "abc", "ab", "a", "x", "xy"},
+		},
+		{
+			name:   "default depth below the shortest route -- weighted",
+			origin: "pq",
+			target: "vw",
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "default depth below the shortest route -- unknown strategy",
+			origin: "pq",
+			target: "vw",
+			opts:   []Option{WithStrategy(Strategy(99))},
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "max depth below the shortest route -- weighted",
+			origin: "xy",
+			target: "abcd",
+			opts:   []Option{WithMaxDepth(5)},
+			err:    ErrNoRoute,
+		},
+		{
+			name:    "short no-response timeout",
+			origin:  "ruby",
+			target:  "rudd",
+			opts:    []Option{WithNoResponseTimeout(time.Millisecond * 50)},
+			maxTime: time.Second,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		start := time.Now()
+		route, err := root.FindRoute(test.origin, test.target, test.opts...)
+		elapsed := time.Since(start)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		// the Weighted strategy is not deterministic, so only the validity of its routes is checked
+		if test.wants == nil && !isValidRoute(root, route, test.origin, test.target) ||
+			test.wants != nil && !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+
+		if test.maxTime > 0 && elapsed > test.maxTime {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] query took too long: wanted at most %v ; took %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.maxTime,
+				elapsed,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
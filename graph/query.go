@@ -2,21 +2,131 @@ package graph
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
-	ErrNonExistent error = errors.New("word does not exist")                       // default error when a word does not exist in the dictionary
-	ErrNoMatches   error = errors.New("no matches found")                          // default error when no matches are found for the query
-	ErrNoRoute     error = errors.New("no route to target")                        // default error when no routes are found
-	ErrSameWord    error = errors.New("origin and target words can't be the same") // default error when providing the same origin / target words
+	ErrNonExistent   error = errors.New("word does not exist")                       // default error when a word does not exist in the dictionary
+	ErrNoMatches     error = errors.New("no matches found")                          // default error when no matches are found for the query
+	ErrNoRoute       error = errors.New("no route to target")                        // default error when no routes are found
+	ErrSameWord      error = errors.New("origin and target words can't be the same") // default error when providing the same origin / target words
+	ErrTimeout       error = errors.New("route query timed out")                     // default error when a route query expires before it is complete
+	ErrInvalidStep   error = errors.New("words are not one change apart")            // default error when consecutive words in a route are not siblings
+	ErrInvalidFormat error = errors.New("invalid frequency list format")             // default error when a frequency list cannot be parsed
+	ErrUnsupported   error = errors.New("option is not supported by this query")     // default error when a query is given an Option it cannot honor
 )
 
+// TimeoutError struct is returned when a route query expires (by reaching its time limit, or when its context is
+// done) before it is complete. It holds the best route found until then (if any), and the underlying context error.
+//
+// It matches ErrTimeout with `errors.Is()`, as well as the underlying error (such as context.DeadlineExceeded).
+type TimeoutError struct {
+	Route []string // best route found before the query expired; nil if none was found
+	Err   error    // underlying context error
+}
+
+// Error method returns the string representation of the TimeoutError
+func (e *TimeoutError) Error() string {
+	if e.Err == nil {
+		return ErrTimeout.Error()
+	}
+
+	return ErrTimeout.Error() + ": " + e.Err.Error()
+}
+
+// Is method allows the TimeoutError to match ErrTimeout with `errors.Is()`
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// Unwrap method returns the underlying context error
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// Role type describes the part a word plays in a query, such as being its origin or its target
+type Role string
+
+const (
+	RoleOrigin   Role = "origin"   // the word a query starts from
+	RoleTarget   Role = "target"   // the word a query is looking for a route to
+	RoleWaypoint Role = "waypoint" // a word a route is required to go through
+)
+
+const (
+	opFindRoute       = "FindRoute"       // operation name for FindRoute() and its variants
+	opFindRoutes      = "FindRoutes"      // operation name for FindRoutes()
+	opFindRoutesBatch = "FindRoutesBatch" // operation name for FindRoutesBatch()
+	opWalkRoutes      = "WalkRoutes"      // operation name for WalkRoutes()
+	opStreamRoutes    = "StreamRoutes"    // operation name for StreamRoutes()
+	opSiblings        = "Siblings"        // operation name for Siblings()
+	opTargetSiblings  = "TargetSiblings"  // operation name for TargetSiblings()
+)
+
+// WordError struct is returned when a word provided in a query does not exist in the dictionary. It holds the
+// offending word, the Role it plays in the query, and the name of the operation that failed; as well as spelling
+// suggestions (existing words one change away from the input word), if there are any.
+//
+// It matches ErrNonExistent with `errors.Is()`.
+type WordError struct {
+	Word        string   // the word which does not exist in the dictionary
+	Role        Role     // the part the word plays in the query
+	Op          string   // the name of the operation that failed
+	Suggestions []string // existing words similar to the input word, if any
+}
+
+// newWordError method will create a WordError for the input word, looking up spelling suggestions for it
+func (n *Node) newWordError(word string, role Role, op string) *WordError {
+	return &WordError{
+		Word:        word,
+		Role:        role,
+		Op:          op,
+		Suggestions: n.suggest(word),
+	}
+}
+
+// Error method returns the string representation of the WordError, such as:
+//
+//	FindRoute: origin "gohper": word does not exist; did you mean "gopher"?
+func (e *WordError) Error() string {
+	var sb = &strings.Builder{}
+
+	sb.WriteString(e.Op)
+	sb.WriteString(": ")
+	sb.WriteString(string(e.Role))
+	sb.WriteString(" ")
+	sb.WriteString(strconv.Quote(e.Word))
+	sb.WriteString(": ")
+	sb.WriteString(ErrNonExistent.Error())
+
+	if len(e.Suggestions) > 0 {
+		sb.WriteString("; did you mean ")
+
+		for idx, s := range e.Suggestions {
+			if idx > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(strconv.Quote(s))
+		}
+
+		sb.WriteString("?")
+	}
+
+	return sb.String()
+}
+
+// Is method allows the WordError to match ErrNonExistent with `errors.Is()`
+func (e *WordError) Is(target error) bool {
+	return target == ErrNonExistent
+}
+
 const (
 	maxQueryTime      = time.Second * 15 // timer ceiling for a FindRoute() operation
 	maxNoResponseTime = maxQueryTime / 5 // timer ceiling for cancelling when no new routes appear after a while
 	maxRoutes         = 5                // maximum number of accumulated routes before halting the query
-	minAccuracy       = 98               // minimum accuracy threshold to validate as a match
 )
 
 // Find method will look up the dictionary for string w, and return true if it exists
@@ -26,7 +136,26 @@ const (
 //
 // Once it reaches the last character, it expects the node to also mark the end of a word, returning a boolean
 // based on this match.
+//
+// The word is normalized with the dictionary's Normalizer (see New()) before being looked up.
 func (n *Node) Find(word string) bool {
+	word, ok := n.normalize(word)
+
+	if !ok {
+		return false
+	}
+
+	return n.has(word)
+}
+
+// has method will look up the dictionary for the word as it is stored (without normalizing it), and return true if
+// it exists
+func (n *Node) has(word string) bool {
+	// words which are not valid UTF-8 are never added to the dictionary
+	if !utf8.ValidString(word) {
+		return false
+	}
+
 	node := n.getRoot()
 
 	return node.rFind(word)
@@ -44,7 +173,7 @@ func (n *Node) rFind(word string) bool {
 	}
 
 	// take the first character
-	char := word[0]
+	char, size := utf8.DecodeRuneInString(word)
 
 	// if this pointer is not initialized, it's not a word in this dictionary
 	if n.charMap[char] == nil {
@@ -53,12 +182,12 @@ func (n *Node) rFind(word string) bool {
 
 	// if the isEnd element for this character is true and this is the last one left,
 	// return true as there is a match
-	if n.charMap[char].isEnd && len(word) == 1 {
+	if n.charMap[char].isEnd && len(word) == size {
 		return true
 	}
 
 	// otherwise, there are more than one characters in the word, continue recursively
-	return n.charMap[char].rFind(word[1:])
+	return n.charMap[char].rFind(word[size:])
 }
 
 // GetNodes method will take in an input word and return a slice of pointers to Nodes, for
@@ -67,7 +196,7 @@ func (n *Node) rFind(word string) bool {
 // It does so by recursivelly calling the `rGetNodes()` method, to populate the output slice
 func (n *Node) GetNodes(word string) []*Node {
 	// short-circuit if the word does not exist or is empty
-	if len(word) == 0 || !n.Find(word) {
+	if len(word) == 0 || !n.has(word) {
 		return []*Node{}
 	}
 
@@ -86,13 +215,13 @@ func (n *Node) rGetNodes(word string) []*Node {
 	}
 
 	// take the first character in the word
-	var char = word[0]
+	var char, size = utf8.DecodeRuneInString(word)
 
 	// store its Node pointer in the output slice
 	out = append(out, n.charMap[char])
 
 	// recursively call this method to populate the slice with its child nodes.
-	out = append(out, n.charMap[char].rGetNodes(word[1:])...)
+	out = append(out, n.charMap[char].rGetNodes(word[size:])...)
 
 	return out
 }
@@ -101,16 +230,20 @@ func (n *Node) rGetNodes(word string) []*Node {
 // a real word. This is done by creating a new word, then matching if it exists in the dictionary.
 //
 // This call will be by default applied to the root, as its `Fuzz()` call will work with the word's
-// corresponding nodes.
+// corresponding nodes. The word is normalized with the dictionary's Normalizer, like in Find().
 func (n *Node) Siblings(origin string) ([]string, error) {
 	// work on the root for a complete word look-up
 	node := n.getRoot()
 
 	// return an error if the word does not exist
-	if !node.Find(origin) {
-		return nil, ErrNonExistent
+	word, ok := node.normalize(origin)
+
+	if !ok || !node.has(word) {
+		return nil, node.newWordError(origin, RoleOrigin, opSiblings)
 	}
 
+	origin = word
+
 	// fuzz the words letters, checking if they are in fact words; returning a slice of all
 	// one-step combinations
 	return node.Fuzz(origin)
@@ -122,15 +255,27 @@ func (n *Node) Siblings(origin string) ([]string, error) {
 // This is done with a `WeighedFuzz()` call, which builds a profile on each result, giving it a weight
 // (a 0-100 score on the number of matched characters) and a potential (number of siblings it has).
 //
-// These results are sorted with a simple quicksort technique that orders by weight and by potential,
-// accordingly. This ensures that a `FindRoutes()` call will prioritize the most "efficient" words.
+// These results are sorted with a simple quicksort technique that orders by weight, by potential and by
+// frequency (if the dictionary has Frequencies, see SetFrequencies()), accordingly. This ensures that a
+// `FindRoutes()` call will prioritize the most "efficient" words.
+//
+// Both words are normalized with the dictionary's Normalizer, like in Find().
 func (n *Node) TargetSiblings(origin, target string) ([]*Result, error) {
 	// work on the root for a complete word look-up
 	node := n.getRoot()
 
 	// return an error if the word does not exist
-	if !node.Find(origin) {
-		return nil, ErrNonExistent
+	word, ok := node.normalize(origin)
+
+	if !ok || !node.has(word) {
+		return nil, node.newWordError(origin, RoleOrigin, opTargetSiblings)
+	}
+
+	origin = word
+
+	// the target does not need to exist, but it is compared to the words in the dictionary
+	if word, ok := node.normalize(target); ok {
+		target = word
 	}
 
 	// fuzz the words letters, checking if they are in fact words; returning a slice of all
@@ -3,6 +3,7 @@ package graph
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"testing"
 )
 
@@ -20,7 +21,7 @@ func TestFind(t *testing.T) {
 	}
 
 	root := New()
-	root.Add("cat", "dog", "cot", "cog")
+	root.Add("cat", "dog", "cot", "cog", "café", "straße")
 
 	var tests = []test{
 		{
@@ -28,6 +29,15 @@ func TestFind(t *testing.T) {
 			query: "cat",
 			ok:    true,
 		},
+		{
+			name:  "valid query with multi-byte characters",
+			query: "straße",
+			ok:    true,
+		},
+		{
+			name:  "query ending in a partial multi-byte character",
+			query: "caf\xc3",
+		},
 		{
 			name:  "zero-length query",
 			query: "",
@@ -39,7 +49,7 @@ func TestFind(t *testing.T) {
 	}
 
 	var verify = func(idx int, test test) {
-		if !root.Find(test.query) && test.ok {
+		if root.Find(test.query) != test.ok {
 			t.Errorf(
 				"#%v -- FAILED -- [%s] [%s] unable to find the added word %s in the graph -- action: %s",
 				idx,
@@ -146,7 +156,7 @@ func TestSiblings(t *testing.T) {
 		siblings, err := root.Siblings(test.query)
 
 		if err != nil {
-			if !errors.Is(test.err, err) {
+			if !errors.Is(err, test.err) {
 				t.Errorf(
 					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
 					idx,
@@ -182,6 +192,105 @@ func TestSiblings(t *testing.T) {
 	}
 }
 
+func TestSiblingsUnicode(t *testing.T) {
+	module := "Graph"
+	funcname := "Siblings()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		query string
+		wants []string
+	}
+
+	root := New()
+	root.Add("pão", "mão", "são", "mãe", "pé", "pá", "pó", "café", "cafe", "cafés")
+	root.Add("straße", "strasse", "ruß", "muß", "mus")
+
+	var tests = []test{
+		{
+			name:  "substitution of a multi-byte character",
+			query: "pé",
+			wants: []string{"pá", "pó"},
+		},
+		{
+			name:  "substitutions around a multi-byte character",
+			query: "mão",
+			wants: []string{"pão", "são", "mãe"},
+		},
+		{
+			name:  "substitution and expansion of a word ending in a multi-byte character",
+			query: "café",
+			wants: []string{"cafe", "cafés"},
+		},
+		{
+			name:  "reduction to a word ending in a multi-byte character",
+			query: "cafés",
+			wants: []string{"café"},
+		},
+		{
+			name:  "substitution of a multi-byte character with a single-byte one",
+			query: "muß",
+			wants: []string{"ruß", "mus"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		siblings, err := root.Siblings(test.query)
+
+		if err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if !reflect.DeepEqual(siblings, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				siblings,
+				test.name,
+			)
+			return
+		}
+
+		// the siblings match the ones from probing the trie
+		probed, _ := root.probe(test.query)
+		sort.Strings(probed)
+
+		sorted := append([]string{}, siblings...)
+		sort.Strings(sorted)
+
+		if !reflect.DeepEqual(sorted, probed) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] probe mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				probed,
+				sorted,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
 func TestTargetSiblings(t *testing.T) {
 	module := "Graph"
 	funcname := "TargetSiblings()"
@@ -257,7 +366,7 @@ func TestTargetSiblings(t *testing.T) {
 		results, err := root.TargetSiblings(test.query, test.target)
 
 		if err != nil {
-			if !errors.Is(test.err, err) {
+			if !errors.Is(err, test.err) {
 				t.Errorf(
 					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
 					idx,
@@ -293,3 +402,189 @@ func TestTargetSiblings(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestTargetSiblingsFrequency(t *testing.T) {
+	module := "Graph"
+	funcname := "TargetSiblings()"
+
+	_ = module
+	_ = funcname
+
+	root := New()
+	root.Add("cat", "dog", "pat", "cot", "cog", "catt")
+	root.SetFrequencies(Frequencies{"cot": 50, "pat": 900, "catt": 10})
+
+	// "pat" and "catt" have the same weight and potential, so the most common word comes first
+	wants := []*Result{
+		{
+			word:      "cot",
+			siblings:  []string{"cat", "cog"},
+			weight:    33,
+			potential: 2,
+			frequency: 50,
+		},
+		{
+			word:      "pat",
+			siblings:  []string{"cat"},
+			weight:    0,
+			potential: 1,
+			frequency: 900,
+		},
+		{
+			word:      "catt",
+			siblings:  []string{"cat"},
+			weight:    0,
+			potential: 1,
+			frequency: 10,
+		},
+	}
+
+	results, err := root.TargetSiblings("cat", "dog")
+
+	if err != nil || !reflect.DeepEqual(results, wants) {
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v (%v) -- action: %s",
+			0,
+			module,
+			funcname,
+			wants,
+			results,
+			err,
+			"rank by frequency after weight and potential",
+		)
+	}
+}
+
+func TestWordError(t *testing.T) {
+	module := "Graph"
+	funcname := "WordError"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		call  func(n *Node) error
+		wants *WordError
+	}
+
+	root := New()
+	root.Add("gopher", "gophers", "cat", "cot", "cart", "dog")
+
+	var tests = []test{
+		{
+			name: "FindRoute() -- missing origin with a swapped character",
+			call: func(n *Node) error {
+				_, err := n.FindRoute("gohper", "dog")
+				return err
+			},
+			wants: &WordError{Word: "gohper", Role: RoleOrigin, Op: "FindRoute", Suggestions: []string{"gopher"}},
+		},
+		{
+			name: "FindRoute() -- missing target with a replaced character",
+			call: func(n *Node) error {
+				_, err := n.FindRoute("cat", "cxt", WithStrategy(BreadthFirst))
+				return err
+			},
+			wants: &WordError{Word: "cxt", Role: RoleTarget, Op: "FindRoute", Suggestions: []string{"cat", "cot"}},
+		},
+		{
+			name: "TargetSiblings() -- missing origin with a removed or replaced character",
+			call: func(n *Node) error {
+				_, err := n.TargetSiblings("crt", "dog")
+				return err
+			},
+			wants: &WordError{Word: "crt", Role: RoleOrigin, Op: "TargetSiblings", Suggestions: []string{"cart", "cat", "cot"}},
+		},
+		{
+			name: "Siblings() -- missing origin without suggestions",
+			call: func(n *Node) error {
+				_, err := n.Siblings("zebra")
+				return err
+			},
+			wants: &WordError{Word: "zebra", Role: RoleOrigin, Op: "Siblings"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		err := test.call(root)
+
+		var wordErr *WordError
+
+		if !errors.Is(err, ErrNonExistent) || !errors.As(err, &wordErr) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				ErrNonExistent,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if !reflect.DeepEqual(wordErr, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %+v ; got %+v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				wordErr,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestWordErrorString(t *testing.T) {
+	module := "Graph"
+	funcname := "WordError.Error()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		err   *WordError
+		wants string
+	}
+
+	var tests = []test{
+		{
+			name:  "with suggestions",
+			err:   &WordError{Word: "gohper", Role: RoleOrigin, Op: "FindRoute", Suggestions: []string{"gopher", "gophers"}},
+			wants: `FindRoute: origin "gohper": word does not exist; did you mean "gopher", "gophers"?`,
+		},
+		{
+			name:  "without suggestions",
+			err:   &WordError{Word: "zebra", Role: RoleTarget, Op: "FindRoute"},
+			wants: `FindRoute: target "zebra": word does not exist`,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		if output := test.err.Error(); output != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				output,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
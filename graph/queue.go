@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// path struct represents a route being explored by the Weighted strategy's workers, along with the
+// metrics (from its last word's Result) used to prioritize it
+type path struct {
+	carry     []string
+	weight    int
+	potential int
+}
+
+// paths type is a priority queue of paths, implementing `heap.Interface`. Paths ending in the heaviest
+// words are popped first, then the ones with the most potential, then the shortest ones.
+type paths []*path
+
+// Len method returns the number of paths in the queue
+func (p paths) Len() int {
+	return len(p)
+}
+
+// Less method compares two paths by weight, potential, and length, accordingly
+func (p paths) Less(i, j int) bool {
+	if p[i].weight != p[j].weight {
+		return p[i].weight > p[j].weight
+	}
+
+	if p[i].potential != p[j].potential {
+		return p[i].potential > p[j].potential
+	}
+
+	return len(p[i].carry) < len(p[j].carry)
+}
+
+// Swap method swaps the paths in indexes i and j
+func (p paths) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+// Push method adds a new path to the queue
+func (p *paths) Push(x any) {
+	*p = append(*p, x.(*path))
+}
+
+// Pop method removes and returns the last path in the queue
+func (p *paths) Pop() any {
+	old := *p
+	item := old[len(old)-1]
+	*p = old[:len(old)-1]
+
+	return item
+}
+
+// workQueue struct is the shared frontier for the Weighted strategy's worker pool. It holds the paths
+// waiting to be explored, and the length of the shortest path which queued each word, so that different
+// branches don't explore the same words over again.
+//
+// As paths are popped by weight (and not by length), a word can be queued by a long path before a shorter
+// one reaches it; in that case, the word is queued again with the shorter path, so that a maximum depth
+// doesn't rule out the routes which only fit in it through that word.
+//
+// Workers pull paths with `pop()` and mark them as explored with `done()`. Once the queue is empty and no
+// worker is exploring a path (as no new paths can come up), or once the context is done, `pop()` returns
+// false to all workers.
+type workQueue struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	items   paths
+	visited map[string]int
+	active  int
+	closed  bool
+}
+
+// newWorkQueue function will create a workQueue with the input words marked as visited, as the first word
+// in all paths; so they are never queued
+func newWorkQueue(words ...string) *workQueue {
+	q := &workQueue{
+		visited: map[string]int{},
+	}
+	q.cond = sync.NewCond(&q.mu)
+
+	for _, w := range words {
+		q.visited[w] = 1
+	}
+
+	return q
+}
+
+// close method will mark the queue as closed, waking up all workers waiting for a path
+func (q *workQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// push method will add a path to the queue, if its last word wasn't visited yet, or if it was only reached
+// by longer paths so far; returning false otherwise
+func (q *workQueue) push(p *path) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	word := p.carry[len(p.carry)-1]
+
+	if length, ok := q.visited[word]; ok && length <= len(p.carry) || q.closed {
+		return false
+	}
+	q.visited[word] = len(p.carry)
+
+	heap.Push(&q.items, p)
+	q.cond.Signal()
+
+	return true
+}
+
+// pop method will return the highest-priority path in the queue, blocking while the queue is empty but other
+// workers are still exploring paths. It returns false once there is nothing left to explore, or if the queue
+// is closed.
+func (q *workQueue) pop() (*path, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for len(q.items) == 0 && q.active > 0 && !q.closed {
+		q.cond.Wait()
+	}
+
+	if q.closed || len(q.items) == 0 {
+		// nothing else will be queued; wake up the remaining workers so they exit too
+		q.closed = true
+		q.cond.Broadcast()
+
+		return nil, false
+	}
+
+	q.active++
+	return heap.Pop(&q.items).(*path), true
+}
+
+// done method will mark a path (retrieved with `pop()`) as explored
+func (q *workQueue) done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.active--
+	q.cond.Broadcast()
+}
@@ -11,6 +11,7 @@ type metricValue int
 const (
 	weightV    metricValue = 0 // placeholder enum entry for weight value
 	potentialV metricValue = 1 // placeholder enum entry for potentual value
+	frequencyV metricValue = 2 // placeholder enum entry for frequency value
 )
 
 // metricsValues is a map connecting different metricValue to their corresponding
@@ -26,6 +27,9 @@ var metricValues = map[metricValue]getterFunc{
 	potentialV: func(r *Result) int {
 		return r.potential
 	},
+	frequencyV: func(r *Result) int {
+		return r.frequency
+	},
 }
 
 // f method is a private method to return a metricValue's getterFunc, from the metricValues
@@ -91,6 +95,13 @@ func quickSortValue(r []*Result, f getterFunc) []*Result {
 // into different slices (range of weights is smaller than range of potential); returning a slice of slices
 // of Result pointers, as ordered blocks, separated by different weights.
 func splitByWeight(r []*Result) [][]*Result {
+	return splitByValue(r, weightV.f())
+}
+
+// splitByValue function will break-down the list of results (ordered by the value retrieved with the
+// getterFunc) into different slices, one for each value; returning a slice of slices of Result pointers,
+// as ordered blocks, separated by different values.
+func splitByValue(r []*Result, f getterFunc) [][]*Result {
 	out := [][]*Result{} // initialize output
 	last := 0            // initialize first (incrementing) index
 	ref := map[int]int{} // initialize index-to-weight map
@@ -103,12 +114,12 @@ func splitByWeight(r []*Result) [][]*Result {
 		if len(out) == 0 {
 			inner := []*Result{result}
 			out = append(out, inner)
-			ref[last] = result.weight
+			ref[last] = f(result)
 			continue
 		}
 
-		if result.weight == out[last][0].weight {
-			// if the result's weight matches the last entry's weight, append it to the
+		if f(result) == f(out[last][0]) {
+			// if the result's value matches the last entry's value, append it to the
 			// last slice
 			out[last] = append(out[last], result)
 
@@ -118,19 +129,20 @@ func splitByWeight(r []*Result) [][]*Result {
 			last++
 			inner := []*Result{result}
 			out = append(out, inner)
-			ref[last] = result.weight
+			ref[last] = f(result)
 		}
 	}
 
 	return out
 }
 
-// quickSort function will order the input list of results by weight, then by potential. This will
-// return a list that prioritizes those results which have the most weight, and within those, the
-// ones that have the most potential are listed first.
+// quickSort function will order the input list of results by weight, then by potential, then by frequency.
+// This will return a list that prioritizes those results which have the most weight, and within those, the
+// ones that have the most potential are listed first; and within those, the most common words.
 //
 // This is achieved by quicksorting by weight, splitting into different slices (per weight), and sorting
-// those slices by potential. Lastly, all results are aggregated and return as a slice of Result pointers.
+// those slices by potential; which are split again (per potential) and sorted by frequency. Lastly, all
+// results are aggregated and return as a slice of Result pointers.
 func quickSort(r []*Result) []*Result {
 	var out [][]*Result
 	var res []*Result
@@ -144,7 +156,16 @@ func quickSort(r []*Result) []*Result {
 	// for slice, quicksort by potential, then append it to the output
 	for _, s := range split {
 		ordered := quickSortValue(s, potentialV.f())
-		out = append(out, ordered)
+
+		// within the same potential, quicksort by frequency; unless all results share the same
+		// frequency (such as when there are no Frequencies), keeping the order as it is
+		for _, p := range splitByValue(ordered, potentialV.f()) {
+			if len(splitByValue(p, frequencyV.f())) > 1 {
+				p = quickSortValue(p, frequencyV.f())
+			}
+
+			out = append(out, p)
+		}
 	}
 
 	// merge output
@@ -12,11 +12,15 @@ import (
 //
 // The potential element represents the number of siblings the word has, for context on how many routes can this
 // word take
+//
+// The frequency element represents how common the word is, as its count in the dictionary's Frequencies (see
+// SetFrequencies()); or zero if it has none
 type Result struct {
 	word      string
 	siblings  []string
 	weight    int
 	potential int
+	frequency int
 }
 
 // String method will return a string representation of a result
@@ -36,20 +40,28 @@ func (r *Result) String() string {
 
 // setWeight method will specify the weight of this word in comparison to the target
 //
-// A weight unit is 100 divided by the length of the word (gold has 4 weightUnits, each of which weighing 25)
+// A weight unit is 100 divided by the length of the longest of both words (gold has 4 weightUnits, each of which
+// weighing 25); the weight is computed from the number of matched characters, so that only the target itself is
+// weighed at 100 -- regardless of its length.
 //
-// The more characters match the target, the bigger the weight
+// The more characters match the target, the bigger the weight. Characters are compared as runes, so a multi-byte
+// character counts as a single one.
 func (r *Result) setWeight(target string) {
-	var weight int = 0
-	var weightUnit = 100 / len(target)
+	var matches int = 0
+	var word, goal = []rune(r.word), []rune(target)
+	var length = len(goal)
+
+	if len(word) > length {
+		length = len(word)
+	}
 
-	for i := 0; i < len(target); i++ {
-		if len(r.word) >= len(target) && r.word[i] == target[i] {
-			weight = weight + weightUnit
+	for i := 0; i < len(goal); i++ {
+		if len(word) >= len(goal) && word[i] == goal[i] {
+			matches++
 		}
 	}
 
-	r.weight = weight
+	r.weight = matches * 100 / length
 }
 
 // setPotential function will define the results' potential by the number of generated siblings it has
@@ -72,3 +84,26 @@ func newResult(target, gen string, matches []string) *Result {
 
 	return result
 }
+
+// letterDistance function will return a lower bound on the number of single-character changes required to
+// turn the word into the target: the number of mismatched characters (in the positions both words share),
+// plus the difference in length between them.
+//
+// As each step (substituting, adding or removing a character) can only change this value by one, it never
+// over-estimates the remaining number of steps, which makes it suitable as a heuristic for A* searches.
+func letterDistance(word, target string) int {
+	var short, long = []rune(word), []rune(target)
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+
+	distance := len(long) - len(short)
+
+	for i := 0; i < len(short); i++ {
+		if short[i] != long[i] {
+			distance++
+		}
+	}
+
+	return distance
+}
@@ -66,3 +66,76 @@ func TestResultPrint(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestLetterDistance(t *testing.T) {
+	module := "Result"
+	funcname := "letterDistance()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		word   string
+		target string
+		wants  int
+	}
+
+	var tests = []test{
+		{
+			name:   "same word",
+			word:   "cat",
+			target: "cat",
+			wants:  0,
+		},
+		{
+			name:   "mismatched characters",
+			word:   "cat",
+			target: "dog",
+			wants:  3,
+		},
+		{
+			name:   "longer word",
+			word:   "cart",
+			target: "cat",
+			wants:  2,
+		},
+		{
+			name:   "shorter word",
+			word:   "ca",
+			target: "cog",
+			wants:  2,
+		},
+		{
+			name:   "multi-byte characters",
+			word:   "café",
+			target: "cafés",
+			wants:  1,
+		},
+		{
+			name:   "mismatched multi-byte characters",
+			word:   "straße",
+			target: "strase",
+			wants:  1,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		if d := letterDistance(test.word, test.target); d != test.wants {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				d,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
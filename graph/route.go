@@ -1,22 +1,208 @@
 package graph
 
 import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// Strategy type represents the algorithm used to look up a route between an origin and a target word
+type Strategy int
+
+const (
+	Weighted      Strategy = iota // default strategy; goroutine-based search guided by the siblings' weight and potential
+	BreadthFirst                  // deterministic breadth-first search, which always returns the shortest route
+	Bidirectional                 // breadth-first search from both the origin and the target, joined where they meet
+	AStar                         // best-first search guided by a letter-distance heuristic, which returns the shortest route
+	Dijkstra                      // lowest-cost-first search, which returns the cheapest route for the configured Costs
+)
+
+// Stats struct holds metrics collected while looking up a route, which allow comparing the
+// performance of the different strategies for the same query.
+type Stats struct {
+	Expanded int64 // number of words whose siblings were looked up during the query
+}
+
 // FindRoute method will take an origin and target words, and return the most efficient path from one word
 // to the other, with single-character changes.
-func (n *Node) FindRoute(origin, target string) ([]string, error) {
+//
+// If there is no route between both words, ErrNoRoute is returned. If the query expires before it is complete,
+// a TimeoutError (matching ErrTimeout) is returned, along with the best route found until then (if any). Words in
+// different connected components (see ComponentOf()) cannot be connected, so ErrNoRoute is returned right away for
+// them, without exploring any route.
+//
+// Both words are normalized with the dictionary's Normalizer (see New()), like in Find(); and so are the words in the
+// Constraints set with WithConstraints(). The returned route holds the words as they are stored in the dictionary.
+//
+// The query can be tuned with Options, such as the Strategy it uses (WithStrategy()), or its time limit
+// (WithTimeout()); otherwise the package's defaults are used.
+func (n *Node) FindRoute(origin, target string, opts ...Option) ([]string, error) {
+	return n.FindRouteContext(context.Background(), origin, target, opts...)
+}
+
+// FindRouteContext method is similar to FindRoute, but the query is bound to the input context. Once the context
+// is cancelled or its deadline passes, all spawned work is halted and the best route found so far (if any) is
+// returned, along with a TimeoutError wrapping the context's error.
+func (n *Node) FindRouteContext(ctx context.Context, origin, target string, opts ...Option) ([]string, error) {
+	return n.route(ctx, newConfig(opts...), origin, target)
+}
+
+// route method will look up a route from the origin to the target word with the configured Strategy, bound to the
+// input context and the configured time limit.
+//
+// If the configured Constraints require the route to go through waypoints, a route is looked up for each leg of the
+// journey (from the origin to the first waypoint, from there to the next one, and so on) with `journey()`; first
+// avoiding the words used in earlier legs (for a loop-free route), and if that rules out all routes, letting the
+// legs share words.
+func (n *Node) route(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	if cfg.stats == nil {
+		cfg.stats = &Stats{}
+	}
+
+	origin, target, err := n.validate(origin, target, opFindRoute)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.unsupported(cfg.strategy, opFindRoute); err != nil {
+		return nil, err
+	}
+
+	cfg = n.normalizeRules(cfg).withOrigin(origin)
+	stops, err := n.stops(cfg, origin, target, opFindRoute)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// set the time limit for this query
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	// without waypoints, there is a single leg
+	if len(stops) == 2 {
+		return n.search(ctx, cfg, origin, target)
+	}
+
+	route, err := n.journey(ctx, cfg, stops, true)
+
+	if errors.Is(err, ErrNoRoute) {
+		return n.journey(ctx, cfg, stops, false)
+	}
+
+	return route, err
+}
+
+// journey method will look up a route going through the input stops, in order, by looking up a route for each leg of
+// the journey (from each stop to the next one) with the configured Strategy. If loopFree is set, each leg cannot go
+// through the words used in the previous ones, nor through the stops ahead of it; otherwise, each leg is looked up
+// on its own, and may go through the same words as the other legs.
+//
+// The maximum depth (if any) is shared by all legs, while exploring: each leg is bounded by the words left in the
+// route, minus one word for each stop ahead of it.
+func (n *Node) journey(ctx context.Context, cfg *config, stops []string, loopFree bool) ([]string, error) {
+	route := []string{stops[0]}
+	depth := cfg.depth()
+
+	for i := 1; i < len(stops); i++ {
+		legCfg := cfg.forLeg()
+
+		if loopFree {
+			// rule out the words used so far, and the stops ahead
+			legCfg = cfg.withBlocked(append(route[:len(route)-1:len(route)-1], stops[i+1:]...)...)
+		}
+
+		if depth > 0 {
+			// the words left for this leg (which starts in the last word so far), leaving one word for
+			// each stop ahead of it
+			legCfg.maxDepth = depth - len(route) + 1 - (len(stops) - 1 - i)
+
+			if legCfg.maxDepth < 2 {
+				return nil, ErrNoRoute
+			}
+		}
+
+		leg, err := n.search(ctx, legCfg, stops[i-1], stops[i])
+
+		if err != nil {
+			// a partial route is only meaningful for a complete journey
+			var timeoutErr *TimeoutError
+			if errors.As(err, &timeoutErr) {
+				return nil, &TimeoutError{Err: timeoutErr.Err}
+			}
+
+			return nil, err
+		}
+
+		route = append(route, leg[1:]...)
+	}
+
+	return route, nil
+}
+
+// search method will look up a route from the origin to the target word with the configured Strategy, bound to the
+// input context.
+func (n *Node) search(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	var route []string
+	var err error
+
+	switch cfg.strategy {
+	case BreadthFirst:
+		route, err = n.breadthFirst(ctx, cfg, origin, target)
+	case Bidirectional:
+		route, err = n.bidirectional(ctx, cfg, origin, target)
+	case AStar:
+		route, err = n.aStar(ctx, cfg, origin, target)
+	case Dijkstra:
+		route, err = n.dijkstra(ctx, cfg, origin, target)
+	default:
+		return n.weighted(ctx, cfg, origin, target)
+	}
+
+	// wrap the context's error if the query expired before it was complete
+	if err != nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		return nil, &TimeoutError{Err: err}
+	}
+
+	return route, err
+}
+
+// validate method will check if the origin and target words are valid for a route query (for the input operation),
+// returning an error if they are the same word, or if either of them does not exist in the dictionary. Otherwise,
+// it returns both words normalized with the dictionary's Normalizer (see New()), as the query should use them.
+func (n *Node) validate(origin, target, op string) (string, string, error) {
+	normOrigin, okOrigin := n.normalize(origin)
+	normTarget, okTarget := n.normalize(target)
+
 	// if the origin is the same as the target, no route needs to be found
-	if origin == target {
-		return nil, ErrSameWord
+	if okOrigin && okTarget && normOrigin == normTarget || origin == target {
+		return "", "", ErrSameWord
+	}
+
+	// if either word doesn't exist, return an error
+	if !okTarget || !n.has(normTarget) {
+		return "", "", n.newWordError(target, RoleTarget, op)
 	}
 
-	// if target doesn't exist, return an error
-	if !n.Find(target) {
-		return nil, ErrNonExistent
+	if !okOrigin || !n.has(normOrigin) {
+		return "", "", n.newWordError(origin, RoleOrigin, op)
 	}
 
+	return normOrigin, normTarget, nil
+}
+
+// weighted method is the original FindRoute() strategy, which spawns goroutines to explore the
+// origin's siblings in order of relevance, returning the shortest route out of the first ones found.
+//
+// The query is bound to the input context; if it expires, a TimeoutError is returned along with the best route
+// found until then (if any). If all routes were explored without reaching the target, ErrNoRoute is returned.
+func (n *Node) weighted(ctx context.Context, cfg *config, origin, target string) ([]string, error) {
+	// keep track of the expanded words in a counter shared by all goroutines
+	var expanded int64 = 1
+
 	// get weighed results for the origin word's siblings
 	r, err := n.TargetSiblings(origin, target)
 
@@ -24,185 +210,289 @@ func (n *Node) FindRoute(origin, target string) ([]string, error) {
 		return nil, err
 	}
 
-	// call burstRoutes() to fire-off goroutines
-	return n.burstRoutes(origin, target, r), nil
+	// call burstRoutes() to fire-off goroutines, with the siblings allowed by the constraints
+	route, err := n.burstRoutes(ctx, cfg, origin, target, cfg.allowedResults(origin, r), &expanded)
+
+	cfg.stats.Expanded += atomic.LoadInt64(&expanded)
+
+	// report if the query expired before it was complete
+	if err != nil {
+		return route, &TimeoutError{Route: route, Err: err}
+	}
+
+	if route == nil {
+		return nil, ErrNoRoute
+	}
+
+	return route, nil
 }
 
 // burstRoutes method will handle the channels and comms necessary for performing this query while
 // leveraging goroutines.
 //
-// This method will serve as a router for generating the first goroutines, and also as a results receiver
-// to return the best match when it gets this.
-func (n *Node) burstRoutes(origin, target string, siblings []*Result) []string {
-	done := make(chan struct{}) // done channel to signal a closure action
-	res := make(chan []string)  // res channel to communicate results
-	out := make(chan []string)  // out channel to communicate the final output
-
-	// iterate through all siblings
+// This method will seed a shared work queue with the origin's siblings, and start a bounded pool of workers
+// (as many as configured) to explore it, while serving as a results receiver to return the best match when it
+// gets this. The workers are bound to the input context, and will halt once it is done.
+//
+// Once the best route is found, the workers are signaled to halt, and this method only returns once all of
+// them have exited. If the input context is done before the query is complete, its error is returned along
+// with the best route found so far.
+func (n *Node) burstRoutes(
+	ctx context.Context,
+	cfg *config,
+	origin, target string,
+	siblings []*Result,
+	expanded *int64,
+) ([]string, error) {
+	// if the target is one of the siblings already, return it before spawning any goroutines
 	for _, s := range siblings {
+		if s.word == target {
+			return []string{origin, s.word}, nil
+		}
+	}
 
-		// initialize a carry slice with the origin and the sibling
-		carry := []string{origin, s.word}
+	// derive a context to halt all goroutines once the best route is returned
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-		// if there is a match already, send done signal to done channel and return results
-		if s.weight >= minAccuracy {
-			return carry
-		}
+	res := make(chan []string)      // res channel to communicate results
+	finished := make(chan struct{}) // finished channel is closed once all workers exit
+	watched := make(chan struct{})  // watched channel is closed once the queue's watcher exits
+	wg := &sync.WaitGroup{}         // wg keeps track of all workers
 
-		// kick off findRoute()
-		go n.findRoute(s.word, target, carry, done, res)
+	// seed the work queue with the origin's siblings
+	q := newWorkQueue(origin)
+	for _, s := range siblings {
+		q.push(&path{
+			carry:     []string{origin, s.word},
+			weight:    s.weight,
+			potential: s.potential,
+		})
 	}
 
-	// kick off findBestRoute() only once
-	go n.findBestRoute(res, out, done)
+	// kick off the workers
+	for i := 0; i < cfg.workers; i++ {
+		wg.Add(1)
+		go n.findRoute(ctx, cfg, wg, q, target, res, expanded)
+	}
+
+	// close the finished channel once all workers exit, so findBestRoute() doesn't
+	// wait for routes that will never come
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	// close the queue once the context is done, waking up any idle workers
+	go func() {
+		defer close(watched)
 
-	// once all goroutines are kicked-off, wait for a results message from the output channel
-	for {
 		select {
-		case result := <-out:
-			return result
+		case <-ctx.Done():
+			q.close()
+		case <-finished:
 		}
-	}
+	}()
+
+	// once all workers are kicked-off, wait for the best route from findBestRoute()
+	route, err := n.findBestRoute(ctx, cfg, res, finished)
+
+	// halt all goroutines and wait for them to exit
+	cancel()
+	<-finished
+	<-watched
+
+	return route, err
 }
 
+// getShortest function will return the shortest route in the input slice of routes (the first one, on a tie),
+// or nil if it is empty.
 func getShortest(input [][]string) []string {
 	if len(input) == 0 {
-		return []string{}
+		return nil
 	}
 
-	var smallest int = 0
 	var smallIdx int = 0
 
 	for idx, v := range input {
-		if idx == 0 {
-			continue
-		}
-
-		if len(v) < smallest {
-			smallest = len(v)
+		if len(v) < len(input[smallIdx]) {
 			smallIdx = idx
 		}
 	}
 	return input[smallIdx]
-
 }
 
-// findBestRoute method takes in a results and output channel (chan []string), and a done channel (chan struct{}),
-// to serve as a listener to these channels.
+// findBestRoute method takes in a context, a results channel (chan []string) and a finished channel (chan struct{}),
+// to serve as a listener to these.
 //
-// This method will set a time limit for this operation, after which is sends off the done signal to halt all queries,
-// and will listen to the results channel, accumulating the received routes
+// This method will listen to the results channel, accumulating the received routes until the context is done or
+// until all goroutines have exited (closing the finished channel), returning the smallest one.
 //
-// As it receives new routes, it places it in the slice as per their length, reserving the smallest one to be returned.
+// After the first route is received, a no-response timer is set (and reset on each new route); if it takes longer than
+// the configured no-response time to get a new route, the smallest one is returned.
 //
-// Once the set maxRoutes value is achieved in its routes slice, it send the smallest to the output channel after sending
-// the done signal.
-func (n *Node) findBestRoute(rCh, out chan []string, done chan struct{}) {
-	routes := [][]string{} // initialize a slice of slices to store the routes
-	var entries int        // keep track of the number of received entries
+// Once the configured maximum number of routes is achieved in its routes slice, it returns the smallest one.
+//
+// If it returns because the context is done, the context's error is returned along with the smallest route.
+func (n *Node) findBestRoute(
+	ctx context.Context,
+	cfg *config,
+	rCh chan []string,
+	finished chan struct{},
+) ([]string, error) {
+	routes := [][]string{}      // initialize a slice of slices to store the routes
+	var idle *time.Timer        // no-response timer, set once the first route is received
+	var idleCh <-chan time.Time // no-response timer's channel; nil (blocking) until the timer is set
+
+	defer func() {
+		if idle != nil {
+			idle.Stop()
+		}
+	}()
 
 	for {
 		select {
-		// safeguard to exit after a period of time
-		case <-time.After(maxQueryTime):
-			out <- getShortest(routes)
-			return
-		case <-done:
-			out <- getShortest(routes)
-			return
+		case <-ctx.Done():
+			return getShortest(routes), ctx.Err()
+		case <-idleCh:
+			return getShortest(routes), nil
+		case <-finished:
+			return getShortest(routes), nil
 		case route := <-rCh:
+			routes = append(routes, route)
 
-			entries++
-			go func(entries *int) {
-				copy := *entries
-				for {
-					select {
-					case <-time.After(maxNoResponseTime):
-						if *entries == copy {
-							done <- struct{}{}
-						}
-					}
-				}
-			}(&entries)
+			if cfg.onRoute != nil {
+				cfg.onRoute(route)
+			}
 
-			routes = append(routes, route)
+			// if length of routes exceeds the set maximum, return the smallest slice
+			if len(routes) > cfg.maxRoutes {
+				return getShortest(routes), nil
+			}
+
+			// (re)set the no-response timer
+			if idle == nil {
+				idle = time.NewTimer(cfg.noResponse)
+				idleCh = idle.C
+				continue
+			}
 
-			// if length of routes exceeds the set maximum, exit by sending
-			// the done signal and pushing the smallest slice to the output channel
-			if len(routes) > maxRoutes {
-				done <- struct{}{}
+			if !idle.Stop() {
+				// drain the channel if the timer expired in the meantime
+				select {
+				case <-idle.C:
+				default:
+				}
 			}
+			idle.Reset(cfg.noResponse)
 		}
 	}
 }
 
-// findRoute method is a recursive call to keep looking up new routes (by exploring new words in the
-// same sequence).
-//
-// it takes in the origin string and the target string for reference. The carry slice will represent
-// the current route, as it is called recursively. the done and results channel will serve as points of
-// communication between the goroutines and other methods.
-//
+// findRoute method is a worker's loop, to keep looking up new routes (by exploring new words in the
+// same sequence) out of the shared work queue.
 //
+// it takes in the target string for reference. Each path pulled from the queue represents a route being
+// explored; its last word's siblings are checked for a match with the target (sending the route to the
+// results channel), or queued as new paths otherwise. The context and results channel will serve as points
+// of communication between the goroutines and other methods, while the WaitGroup keeps track of all workers
+// (each one marks itself as done when it returns).
 func (n *Node) findRoute(
-	origin string, target string,
-	carry []string,
-	done chan struct{},
+	ctx context.Context,
+	cfg *config,
+	wg *sync.WaitGroup,
+	q *workQueue,
+	target string,
 	rCh chan []string,
+	expanded *int64,
 ) {
-	// kick-off a goroutine as a controller, to close this call and its children when the
-	// done signal is called
-	go func() {
-		select {
-		case <-done:
+	defer wg.Done()
+
+	for {
+		p, ok := q.pop()
+
+		if !ok {
 			return
 		}
-	}()
 
-	// hard-limit for the carry length -- cannot exceed 3x the size of the word
-	// so, "cat" to "dog" cannot take 9 or more operations to complete
-	if len(carry) >= len(origin)*3 {
+		n.explore(ctx, cfg, q, p.carry, target, rCh, expanded)
+		q.done()
+	}
+}
+
+// explore method will look up the siblings of the last word in the carry slice, sending a route to the
+// results channel if one of them is a match with the target; or queueing them as new paths otherwise.
+func (n *Node) explore(
+	ctx context.Context,
+	cfg *config,
+	q *workQueue,
+	carry []string,
+	target string,
+	rCh chan []string,
+	expanded *int64,
+) {
+	origin := carry[len(carry)-1]
+
+	// hard-limit for the carry length -- cannot exceed the configured depth, which defaults
+	// to 3x the size of the query's origin; so, a route from "cat" to "dog" cannot have more than 9 words
+	if depth := cfg.depth(); depth > 0 && len(carry) >= depth {
 		return
 	}
 
-	// get weighted results
+	// get weighted results, allowed by the constraints
+	atomic.AddInt64(expanded, 1)
 	r, err := n.TargetSiblings(origin, target)
 
 	if err != nil {
 		return
 	}
 
+	r = cfg.allowedResults(origin, r)
+
 	// cycle through each sibling
 	for _, sibling := range r {
-		var exists bool
-
-		// check if the current sibling is already present as one of the items in the carry routes
-		for _, carryObj := range carry {
-			if sibling.word == carryObj {
-				exists = true
-				break
-			}
+		// stop exploring once the context is done
+		if ctx.Err() != nil {
+			return
 		}
 
-		// skip it if so
-		if exists {
+		// check if the current sibling is already present as one of the items in the carry routes,
+		// and skip it if so
+		if inCarry(carry, sibling.word) {
 			continue
 		}
 
-		// append this word to the routes list
-		carry = append(carry, sibling.word)
+		// append this word to a copy of the routes list, as it is shared with other paths
+		route := append(carry[:len(carry):len(carry)], sibling.word)
 
-		// check if its weight counts passes as a match, if so send this carry slice to
-		// the results channel, and return
-		if sibling.weight >= minAccuracy {
-			rCh <- carry
+		// check if this sibling is the target, if so send this carry slice to the results channel,
+		// and return; the siblings' weight only sets the order in which the queued paths are explored
+		if sibling.word == target {
+			select {
+			case rCh <- route:
+			case <-ctx.Done():
+			}
 			return
 		}
 
-		// otherwise, keep exploring the siblings in a new goroutine, with this sibling's word
-		// as the origin instead
-		go n.findRoute(sibling.word, target, carry, done, rCh)
+		// otherwise, queue this sibling to be explored by the workers (unless another
+		// branch already did so)
+		q.push(&path{
+			carry:     route,
+			weight:    sibling.weight,
+			potential: sibling.potential,
+		})
+	}
+}
 
+// inCarry function will return true if the input word is already present as one of the items in the
+// carry slice (the route being explored)
+func inCarry(carry []string, word string) bool {
+	for _, carryObj := range carry {
+		if word == carryObj {
+			return true
+		}
 	}
 
+	return false
 }
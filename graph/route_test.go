@@ -1,9 +1,18 @@
 package graph
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"math/rand"
+	"os"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+	"unicode/utf8"
 )
 
 func TestFindRoute(t *testing.T) {
@@ -121,3 +130,1094 @@ func TestFindRoute(t *testing.T) {
 		verify(idx, test)
 	}
 }
+
+func TestFindRouteBreadthFirst(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute(WithStrategy(BreadthFirst))"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		origin string
+		target string
+		wants  []string
+		err    error
+	}
+
+	root := New()
+	root.Add("ruby", "rudy", "tubb", "tuby", "rubb", "rudd", "mudd", "muda", "bare", "rare", "rabi")
+	root.Add("cat", "cot", "cog", "dog", "dot", "cag")
+
+	var tests = []test{
+		{
+			name:   "valid 5-step route",
+			origin: "ruby",
+			target: "muda",
+			wants: []string{
+				"ruby", "rudy", "rudd", "mudd", "muda",
+			},
+		},
+		{
+			name:   "valid 2-step route",
+			origin: "ruby",
+			target: "rudy",
+			wants: []string{
+				"ruby", "rudy",
+			},
+		},
+		{
+			name:   "deterministic route out of several shortest routes",
+			origin: "cat",
+			target: "dog",
+			wants: []string{
+				"cat", "cag", "cog", "dog",
+			},
+		},
+		{
+			name:   "invalid call -- origin is same as target",
+			origin: "ruby",
+			target: "ruby",
+			err:    ErrSameWord,
+		},
+		{
+			name:   "invalid call -- target not in graph",
+			origin: "ruby",
+			target: "raly",
+			err:    ErrNonExistent,
+		},
+		{
+			name:   "invalid call -- origin not in graph",
+			origin: "raly",
+			target: "ruby",
+			err:    ErrNonExistent,
+		},
+		{
+			name:   "zero routes found",
+			origin: "rabi",
+			target: "rudy",
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "zero routes found -- disconnected words",
+			origin: "cat",
+			target: "ruby",
+			err:    ErrNoRoute,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		// run it more than once to ensure the output is always the same
+		for i := 0; i < 3; i++ {
+			route, err := root.FindRoute(test.origin, test.target, WithStrategy(BreadthFirst))
+
+			if err != nil || test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						test.err,
+						err,
+						test.name,
+					)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(route, test.wants) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.wants,
+					route,
+					test.name,
+				)
+				return
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteBidirectional(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute(WithStrategy(Bidirectional))"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		words []string
+	}
+
+	var tests = []test{
+		{
+			name:  "FindRoute() test dictionary",
+			words: []string{"ruby", "rudy", "tubb", "tuby", "rubb", "rudd", "mudd", "muda", "bare", "rare", "rabi"},
+		},
+		{
+			name:  "dictionary with several shortest routes",
+			words: []string{"cat", "cot", "cog", "dog", "dot", "cag"},
+		},
+		{
+			name:  "dictionary with expanded and reduced words",
+			words: []string{"cat", "dog", "pat", "cot", "cog", "fur", "fir", "zap", "catt", "cart", "care", "core", "cor"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		root := New()
+		root.Add(test.words...)
+
+		// compare every pair of words against a plain breadth-first search
+		for _, origin := range test.words {
+			for _, target := range test.words {
+				wants, wantsErr := root.FindRoute(origin, target, WithStrategy(BreadthFirst))
+				route, err := root.FindRoute(origin, target, WithStrategy(Bidirectional))
+
+				if !sameError(err, wantsErr) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] unexpected error occurred for %s -> %s: wanted %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						wantsErr,
+						err,
+						test.name,
+					)
+					return
+				}
+
+				if len(route) != len(wants) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] route length mismatch for %s -> %s: wanted %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						wants,
+						route,
+						test.name,
+					)
+					return
+				}
+
+				if !isValidRoute(root, route, origin, target) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] invalid route for %s -> %s: %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						route,
+						test.name,
+					)
+					return
+				}
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+// isValidRoute function checks that the input route goes from the origin to the target, with each
+// word being a sibling of the previous one. An empty route is always considered valid.
+func isValidRoute(root *Node, route []string, origin, target string) bool {
+	if len(route) == 0 {
+		return true
+	}
+
+	if route[0] != origin || route[len(route)-1] != target {
+		return false
+	}
+
+	for i := 1; i < len(route); i++ {
+		siblings, err := root.Siblings(route[i-1])
+
+		if err != nil {
+			return false
+		}
+
+		var ok bool
+		for _, s := range siblings {
+			if s == route[i] {
+				ok = true
+				break
+			}
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestFindRouteAStar(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute(WithStrategy(AStar), WithStats())"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		words []string
+	}
+
+	var tests = []test{
+		{
+			name:  "FindRoute() test dictionary",
+			words: []string{"ruby", "rudy", "tubb", "tuby", "rubb", "rudd", "mudd", "muda", "bare", "rare", "rabi"},
+		},
+		{
+			name:  "dictionary with several shortest routes",
+			words: []string{"cat", "cot", "cog", "dog", "dot", "cag"},
+		},
+		{
+			name:  "dictionary with expanded and reduced words",
+			words: []string{"cat", "dog", "pat", "cot", "cog", "fur", "fir", "zap", "catt", "cart", "care", "core", "cor"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		root := New()
+		root.Add(test.words...)
+
+		// compare every pair of words against a plain breadth-first search
+		for _, origin := range test.words {
+			for _, target := range test.words {
+				bfsStats, stats := &Stats{}, &Stats{}
+				wants, wantsErr := root.FindRoute(origin, target, WithStrategy(BreadthFirst), WithStats(bfsStats))
+				route, err := root.FindRoute(origin, target, WithStrategy(AStar), WithStats(stats))
+
+				if !sameError(err, wantsErr) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] unexpected error occurred for %s -> %s: wanted %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						wantsErr,
+						err,
+						test.name,
+					)
+					return
+				}
+
+				if len(route) != len(wants) || !isValidRoute(root, route, origin, target) {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] route mismatch for %s -> %s: wanted %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						wants,
+						route,
+						test.name,
+					)
+					return
+				}
+
+				if err == nil && stats.Expanded > bfsStats.Expanded {
+					t.Errorf(
+						"#%v -- FAILED -- [%s] [%s] expanded more words than a breadth-first search for %s -> %s: wanted at most %v ; got %v -- action: %s",
+						idx,
+						module,
+						funcname,
+						origin,
+						target,
+						bfsStats.Expanded,
+						stats.Expanded,
+						test.name,
+					)
+					return
+				}
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteUnicode(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		strategy Strategy
+		origin   string
+		target   string
+		wants    int
+	}
+
+	// Portuguese and German words, with multi-byte characters
+	root := New()
+	root.Add("pão", "mão", "são", "mãe", "mães", "pé", "pá", "pó", "café", "cafe", "cafés")
+	root.Add("ruß", "muß", "mus", "maus", "haus")
+
+	var tests = []test{}
+
+	for _, strategy := range []Strategy{Weighted, BreadthFirst, Bidirectional, AStar, Dijkstra} {
+		tests = append(tests,
+			test{
+				name:     fmt.Sprintf("Portuguese words (strategy %v)", strategy),
+				strategy: strategy,
+				origin:   "pão",
+				target:   "mães",
+				wants:    4,
+			},
+			test{
+				name:     fmt.Sprintf("German words (strategy %v)", strategy),
+				strategy: strategy,
+				origin:   "ruß",
+				target:   "mus",
+				wants:    3,
+			},
+		)
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := root.FindRoute(test.origin, test.target, WithStrategy(test.strategy))
+
+		if err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if len(route) != test.wants || !isValidRoute(root, route, test.origin, test.target) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] invalid route: wanted %v words ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+
+		for _, w := range route {
+			if !utf8.ValidString(w) || !root.Find(w) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] corrupted word %q in route %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					w,
+					route,
+					test.name,
+				)
+				return
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteContext(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRouteContext()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name    string
+		origin  string
+		target  string
+		timeout time.Duration
+		cancel  bool
+		err     error
+	}
+
+	// build a dense dictionary with all 4-letter combinations of a few characters, so that
+	// the query keeps going for a while
+	root := New()
+	root.Add(combinations("abcdefgh", 4)...)
+
+	// keep accumulating routes until the context is done
+	opts := []Option{WithMaxRoutes(1 << 20), WithNoResponseTimeout(time.Minute)}
+
+	var tests = []test{
+		{
+			name:    "deadline passes before the query is complete",
+			origin:  "aaaa",
+			target:  "ffff",
+			timeout: time.Millisecond * 50,
+			err:     context.DeadlineExceeded,
+		},
+		{
+			name:   "context is cancelled before the query starts",
+			origin: "aaaa",
+			target: "ffff",
+			cancel: true,
+			err:    context.Canceled,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		if test.timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, test.timeout)
+			defer cancel()
+		}
+
+		if test.cancel {
+			cancel()
+		}
+
+		start := time.Now()
+		route, err := root.FindRouteContext(ctx, test.origin, test.target, opts...)
+		elapsed := time.Since(start)
+
+		if !errors.Is(err, test.err) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.err,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if elapsed > maxNoResponseTime {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] query wasn't halted promptly: took %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				elapsed,
+				test.name,
+			)
+			return
+		}
+
+		// a partial result must still be a valid route
+		if len(route) > 0 && !isValidRoute(root, route, test.origin, test.target) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] invalid partial route: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteGoroutines(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+	action := "ensure all spawned goroutines exit once the query returns"
+
+	root := New()
+	root.Add("ruby", "rudy", "tubb", "tuby", "rubb", "rudd", "mudd", "muda", "bare", "rare", "rabi")
+	root.Add("cat", "cot", "cog", "dog", "dot", "cag")
+
+	// dense dictionary, where queries are halted by their timeout while still spawning goroutines
+	dense := New()
+	dense.Add(combinations("abcdef", 4)...)
+
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 5; i++ {
+		_, _ = dense.FindRoute("aaaa", "ffff",
+			WithTimeout(time.Millisecond*20), WithMaxRoutes(1<<20), WithNoResponseTimeout(time.Minute),
+		)
+	}
+
+	for i := 0; i < 50; i++ {
+		_, _ = root.FindRoute("ruby", "muda", WithNoResponseTimeout(time.Millisecond))
+		_, _ = root.FindRoute("cat", "dog", WithNoResponseTimeout(time.Millisecond))
+		_, _ = root.FindRoute("cat", "ruby")
+		_, _ = root.FindRoute("ruby", "mudd", WithTimeout(time.Millisecond))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, _ = root.FindRouteContext(ctx, "ruby", "muda")
+	}
+
+	// goroutines which marked themselves as done may take a moment to be accounted for
+	var after int
+	for i := 0; i < 100; i++ {
+		if after = runtime.NumGoroutine(); after <= before {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	t.Errorf(
+		"FAILED -- [%s] [%s] goroutines leaked: wanted at most %v ; got %v -- action: %s",
+		module,
+		funcname,
+		before,
+		after,
+		action,
+	)
+}
+
+func TestFindRouteWeightedMaxDepth(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+	action := "weighted route under the shortest route's length as the maximum depth"
+
+	_ = module
+	_ = funcname
+
+	// a dense, irregular dictionary, where the Weighted strategy often reaches a word through a long route
+	// before reaching it through a shorter one
+	rng := rand.New(rand.NewSource(2))
+	root := New()
+	words := []string{}
+
+	for length := 1; length <= 4; length++ {
+		for _, w := range combinations("abcd", length) {
+			if rng.Intn(100) < 35 {
+				words = append(words, w)
+			}
+		}
+	}
+
+	root.Add(words...)
+
+	for idx := 0; idx < 60; idx++ {
+		origin, target := words[rng.Intn(len(words))], words[rng.Intn(len(words))]
+
+		shortest, err := root.FindRoute(origin, target, WithStrategy(BreadthFirst))
+
+		if err != nil {
+			continue
+		}
+
+		// any route within the maximum depth is a shortest route
+		route, err := root.FindRoute(origin, target,
+			WithMaxDepth(len(shortest)),
+			WithNoResponseTimeout(time.Millisecond*50),
+		)
+
+		if err != nil || len(route) != len(shortest) || !isValidRoute(root, route, origin, target) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted a route like %v ; got %v (%v) -- action: %s",
+				idx,
+				module,
+				funcname,
+				shortest,
+				route,
+				err,
+				action,
+			)
+		}
+	}
+}
+
+// combinations function returns all words of the input length made out of the input characters
+func combinations(chars string, length int) []string {
+	if length == 0 {
+		return []string{""}
+	}
+
+	var out []string
+
+	for _, prefix := range combinations(chars, length-1) {
+		for i := 0; i < len(chars); i++ {
+			out = append(out, prefix+string(chars[i]))
+		}
+	}
+
+	return out
+}
+
+// benchmarkDictionary function will load the word list in the path set in the ${WORD_LIST} env variable (such
+// as dwyl's list) if available; otherwise, a dense dictionary is generated. It returns the graph and an origin and
+// target words for the benchmark.
+func benchmarkDictionary(b *testing.B) (*Node, string, string) {
+	b.Helper()
+
+	root := New()
+
+	if path := os.Getenv("WORD_LIST"); path != "" {
+		if data, err := os.ReadFile(path); err == nil {
+			root.Add(fromBytes(data)...)
+			return root, "gopher", "logic"
+		}
+	}
+
+	root.Add(combinations("abcdefg", 4)...)
+	return root, "aaaa", "gfed"
+}
+
+func BenchmarkFindRoute(b *testing.B) {
+	root, origin, target := benchmarkDictionary(b)
+
+	// leave the indexes' build time out of the queries
+	root.Build()
+
+	type bench struct {
+		name     string
+		opts     []Option
+		baseline bool
+	}
+
+	var benches = []bench{
+		// the Weighted strategy before its worker pool, for comparison
+		{name: "Baseline/goroutine-per-sibling", baseline: true},
+		{name: "Weighted/workers=1", opts: []Option{WithWorkers(1)}},
+		{name: "Weighted/workers=4", opts: []Option{WithWorkers(4)}},
+		{name: fmt.Sprintf("Weighted/workers=%d", runtime.NumCPU()), opts: []Option{WithWorkers(runtime.NumCPU())}},
+		{name: "BreadthFirst", opts: []Option{WithStrategy(BreadthFirst)}},
+		{name: "Bidirectional", opts: []Option{WithStrategy(Bidirectional)}},
+		{name: "AStar", opts: []Option{WithStrategy(AStar)}},
+	}
+
+	for _, bench := range benches {
+		opts := append(bench.opts, WithNoResponseTimeout(time.Millisecond*50), WithTimeout(time.Second))
+
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if bench.baseline {
+					_ = baselineRoute(root, newConfig(opts...).withOrigin(origin), origin, target)
+					continue
+				}
+
+				_, _ = root.FindRoute(origin, target, opts...)
+			}
+		})
+	}
+}
+
+// baselineRoute function will look up a route like the Weighted strategy did before its worker pool, by spawning a
+// goroutine for each sibling (recursively, without a limit); it is kept as a baseline for BenchmarkFindRoute
+func baselineRoute(root *Node, cfg *config, origin, target string) []string {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.timeout)
+	defer cancel()
+
+	res := make(chan []string)
+	finished := make(chan struct{})
+	wg := &sync.WaitGroup{}
+
+	wg.Add(1)
+	go baselineExplore(ctx, root, cfg, wg, []string{origin}, target, res)
+
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	route, _ := root.findBestRoute(ctx, cfg, res, finished)
+
+	// halt all goroutines and wait for them to exit
+	cancel()
+	<-finished
+
+	return route
+}
+
+// baselineExplore function will look up the siblings of the last word in the carry slice, sending a route to the
+// results channel if one of them is a match with the target; or exploring each of them in a new goroutine otherwise
+func baselineExplore(
+	ctx context.Context,
+	root *Node,
+	cfg *config,
+	wg *sync.WaitGroup,
+	carry []string,
+	target string,
+	rCh chan []string,
+) {
+	defer wg.Done()
+
+	if depth := cfg.depth(); ctx.Err() != nil || depth > 0 && len(carry) >= depth {
+		return
+	}
+
+	r, err := root.TargetSiblings(carry[len(carry)-1], target)
+
+	if err != nil {
+		return
+	}
+
+	for _, sibling := range r {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if inCarry(carry, sibling.word) {
+			continue
+		}
+
+		route := append(carry[:len(carry):len(carry)], sibling.word)
+
+		if sibling.word == target {
+			select {
+			case rCh <- route:
+			case <-ctx.Done():
+			}
+			return
+		}
+
+		wg.Add(1)
+		go baselineExplore(ctx, root, cfg, wg, route, target, rCh)
+	}
+}
+
+func TestFindRouteSuccess(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		strategy Strategy
+	}
+
+	root := New()
+	root.Add("hopper", "copper", "copier", "topper", "hoppers", "cat", "cot", "cog", "dog")
+
+	var tests = []test{
+		{name: "6-letter target -- weighted", strategy: Weighted},
+		{name: "6-letter target -- breadth-first", strategy: BreadthFirst},
+		{name: "6-letter target -- bidirectional", strategy: Bidirectional},
+		{name: "6-letter target -- A*", strategy: AStar},
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := root.FindRoute("hopper", "copier", WithStrategy(test.strategy))
+
+		if err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if len(route) == 0 || !isValidRoute(root, route, "hopper", "copier") {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] invalid route: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteNearMiss(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+
+	type test struct {
+		name     string
+		strategy Strategy
+	}
+
+	// the near-miss word shares 51 of the target's 52 characters, which weighs 98 -- as high as a match would
+	// have been weighed; while the route to the target goes through it
+	var (
+		target   = strings.Repeat("a", 51) + "b"
+		nearMiss = strings.Repeat("a", 51) + "c"
+		origin   = "c" + strings.Repeat("a", 50) + "c"
+	)
+
+	root := New()
+	root.Add(origin, nearMiss, target)
+
+	var tests = []test{
+		{name: "near-miss above the weight threshold -- weighted", strategy: Weighted},
+		{name: "near-miss above the weight threshold -- breadth-first", strategy: BreadthFirst},
+	}
+
+	var verify = func(idx int, test test) {
+		if w := newResult(target, nearMiss, nil).weight; w < 98 {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] the near-miss word is weighed %v, below 98 -- action: %s",
+				idx,
+				module,
+				funcname,
+				w,
+				test.name,
+			)
+			return
+		}
+
+		route, err := root.FindRoute(origin, target, WithStrategy(test.strategy))
+
+		if err != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if wants := []string{origin, nearMiss, target}; !reflect.DeepEqual(route, wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				wants,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteNoRoute(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		strategy Strategy
+	}
+
+	// "cat" and "dog" both have siblings, but there is no route between them
+	root := New()
+	root.Add("cat", "cot", "pat", "dog", "dig", "fog")
+
+	var tests = []test{
+		{name: "disconnected words -- weighted", strategy: Weighted},
+		{name: "disconnected words -- breadth-first", strategy: BreadthFirst},
+		{name: "disconnected words -- bidirectional", strategy: Bidirectional},
+		{name: "disconnected words -- A*", strategy: AStar},
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := root.FindRoute("cat", "dog", WithStrategy(test.strategy))
+
+		if !errors.Is(err, ErrNoRoute) || errors.Is(err, ErrTimeout) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				ErrNoRoute,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		if route != nil {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] expected a nil route: got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestFindRouteTimeout(t *testing.T) {
+	module := "Graph"
+	funcname := "FindRoute()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name     string
+		target   string
+		strategy Strategy
+		timeout  time.Duration
+	}
+
+	// build a dense dictionary so that the queries take longer than their time limit, with
+	// a word only reachable through a long detour, so that even guided searches have to explore
+	// most of it
+	root := New()
+	root.Add(combinations("abcdefgh", 5)...)
+	root.Add("hhhhz", "hhhzz", "hhzzz", "hzzzz", "zzzzz")
+	root.Build()
+
+	var tests = []test{
+		{name: "query expires -- weighted", target: "zzzzz", strategy: Weighted, timeout: time.Millisecond},
+		{name: "query expires -- breadth-first", target: "zzzzz", strategy: BreadthFirst, timeout: time.Millisecond},
+		// the bidirectional search is quick to join both ends of the detour, even in this dictionary
+		{name: "query expires -- bidirectional", target: "zzzzz", strategy: Bidirectional, timeout: time.Nanosecond},
+		{name: "query expires -- A*", target: "zzzzz", strategy: AStar, timeout: time.Millisecond},
+	}
+
+	var verify = func(idx int, test test) {
+		route, err := root.FindRoute("aaaaa", test.target,
+			WithStrategy(test.strategy),
+			WithTimeout(test.timeout),
+			WithMaxRoutes(1<<20),
+			WithNoResponseTimeout(time.Minute),
+		)
+
+		var timeoutErr *TimeoutError
+
+		if !errors.Is(err, ErrTimeout) || !errors.Is(err, context.DeadlineExceeded) || !errors.As(err, &timeoutErr) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				ErrTimeout,
+				err,
+				test.name,
+			)
+			return
+		}
+
+		// a partial result must still be a valid route, and match the one in the error
+		if !isValidRoute(root, route, "aaaaa", test.target) || !reflect.DeepEqual(route, timeoutErr.Route) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] invalid partial route: %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestGetShortest(t *testing.T) {
+	module := "Graph"
+	funcname := "getShortest()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name  string
+		input [][]string
+		wants []string
+	}
+
+	var tests = []test{
+		{
+			name: "nil input",
+		},
+		{
+			name:  "shortest route is not the first one",
+			input: [][]string{{"a", "b", "c", "d"}, {"a", "c", "d"}, {"a", "d"}, {"a", "e", "d"}},
+			wants: []string{"a", "d"},
+		},
+		{
+			name:  "tie between routes",
+			input: [][]string{{"a", "b", "d"}, {"a", "c", "d"}},
+			wants: []string{"a", "b", "d"},
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		if route := getShortest(test.input); !reflect.DeepEqual(route, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				route,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+// sameError function checks if both errors are nil, or have the same message
+func sameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Error() == b.Error()
+}
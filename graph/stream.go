@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"context"
+)
+
+// StreamRoutes method will take an origin and target words, and stream the routes from one word to the other
+// (with single-character changes) as they are found, so that a first answer can be shown right away and refined
+// as the query goes on.
+//
+// Routes are sent in improving order: each Route on the channel is shorter than the previous one, so the last
+// one received is the best route of the query. With the default (Weighted) strategy, a route is sent as soon as
+// one of the workers finds it; the remaining strategies (and routes with waypoints) only find a single route,
+// which is sent once the query is complete. The channel is closed once the query ends, be it because all routes
+// were explored, because of the Options that tune the query (such as WithMaxRoutes() or WithTimeout()), or
+// because the input context is done.
+//
+// The caller must either receive from the channel until it is closed, or cancel the input context, so that
+// the query is halted and its goroutines exit.
+//
+// If either word does not exist in the dictionary, if both words are not connected, or if the Constraints set
+// with WithConstraints() rule out all routes beforehand, an error is returned and no query is done. Any other
+// outcome (such as not finding any route, or the query expiring) is conveyed by the channel being closed.
+func (n *Node) StreamRoutes(ctx context.Context, origin, target string, opts ...Option) (<-chan Route, error) {
+	cfg := newConfig(opts...)
+
+	origin, target, err := n.validate(origin, target, opStreamRoutes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.unsupported(cfg.strategy, opStreamRoutes); err != nil {
+		return nil, err
+	}
+
+	cfg = n.normalizeRules(cfg)
+
+	if _, err := n.stops(cfg, origin, target, opStreamRoutes); err != nil {
+		return nil, err
+	}
+
+	out := make(chan Route)
+
+	go func() {
+		defer close(out)
+
+		var best []string
+
+		// send a route if it is shorter than the last one sent, unless the context is done
+		emit := func(words []string) {
+			if best != nil && len(words) >= len(best) {
+				return
+			}
+
+			route, err := NewRoute(words)
+
+			if err != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+			case out <- route:
+				best = words
+			}
+		}
+
+		cfg.onRoute = emit
+
+		// the best route is sent once the query ends, as it may not have been streamed yet (or at all, for
+		// strategies other than Weighted)
+		if route, _ := n.route(ctx, cfg, origin, target); route != nil {
+			emit(route)
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStreamRoutes(t *testing.T) {
+	module := "Graph"
+	funcname := "StreamRoutes()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		origin string
+		target string
+		opts   []Option
+		wants  [][]string
+		err    error
+	}
+
+	// build a dense dictionary with all 4-letter combinations of a few characters, so that
+	// several routes are found
+	root := New()
+	root.Add(combinations("abcdef", 4)...)
+	root.Add("zzzzz")
+
+	var tests = []test{
+		{
+			name:   "weighted routes in improving order",
+			origin: "aaaa",
+			target: "ffff",
+			opts:   []Option{WithNoResponseTimeout(time.Millisecond * 50)},
+		},
+		{
+			name:   "single breadth-first route",
+			origin: "aaaa",
+			target: "aaff",
+			opts:   []Option{WithStrategy(BreadthFirst)},
+			wants:  [][]string{{"aaaa", "aaaf", "aaff"}},
+		},
+		{
+			name:   "route with waypoints",
+			origin: "aaaa",
+			target: "aaff",
+			opts:   []Option{WithStrategy(AStar), WithConstraints(Constraints{Waypoints: []string{"baaa"}})},
+			wants:  [][]string{{"aaaa", "baaa", "baaf", "aaaf", "aaff"}},
+		},
+		{
+			name:   "no route closes the channel",
+			origin: "aaaa",
+			target: "ffff",
+			opts:   []Option{WithStrategy(BreadthFirst), WithMaxDepth(4)},
+			wants:  [][]string{},
+		},
+		{
+			name:   "disconnected words",
+			origin: "aaaa",
+			target: "zzzzz",
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "non-existing origin",
+			origin: "gggg",
+			target: "aaaa",
+			err:    ErrNonExistent,
+		},
+		{
+			name:   "same word",
+			origin: "aaaa",
+			target: "aaaa",
+			err:    ErrSameWord,
+		},
+		{
+			name:   "forbidden target",
+			origin: "aaaa",
+			target: "ffff",
+			opts:   []Option{WithConstraints(Constraints{Forbidden: []string{"ffff"}})},
+			err:    ErrNoRoute,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		routes, err := root.StreamRoutes(context.Background(), test.origin, test.target, test.opts...)
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		output := [][]string{}
+		for r := range routes {
+			output = append(output, r.Words())
+		}
+
+		if test.wants != nil {
+			if !reflect.DeepEqual(output, test.wants) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.wants,
+					output,
+					test.name,
+				)
+			}
+			return
+		}
+
+		// the Weighted strategy is not deterministic, so only the validity and order of its routes is checked
+		if len(output) == 0 {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] no routes were streamed -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.name,
+			)
+			return
+		}
+
+		for i, route := range output {
+			if !isValidRoute(root, route, test.origin, test.target) || i > 0 && len(route) >= len(output[i-1]) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] invalid or out of order route: got %v after %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					route,
+					output[:i],
+					test.name,
+				)
+				return
+			}
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
+
+func TestStreamRoutesCancel(t *testing.T) {
+	module := "Graph"
+	funcname := "StreamRoutes()"
+
+	_ = module
+	_ = funcname
+
+	root := New()
+	root.Add(combinations("abcdefgh", 4)...)
+
+	// keep accumulating routes until the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	routes, err := root.StreamRoutes(ctx, "aaaa", "ffff", WithMaxRoutes(1<<20), WithNoResponseTimeout(time.Minute))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// read the first route, then stop reading and cancel the query
+	<-routes
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for range routes {
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf(
+			"#%v -- FAILED -- [%s] [%s] channel was not closed after the context was cancelled -- action: %s",
+			0,
+			module,
+			funcname,
+			"cancel after the first route",
+		)
+	}
+}
@@ -0,0 +1,85 @@
+package graph
+
+// WalkRoutes method will look up every route from the origin to the target word with at most maxLen words
+// (including the origin and the target), calling the input function with each route as soon as it is found.
+//
+// Routes are explored depth-first, with the siblings of each word (from `Fuzz()`) visited in alphabetical order,
+// and no word showing up twice in the same route. The routes passed to the input function are not modified
+// afterwards, so they are safe to keep. If the input function returns false, the walk stops early.
+//
+// If there is no route between both words within maxLen words, ErrNoRoute is returned. Bear in mind that the
+// number of routes grows very quickly with maxLen, on large dictionaries.
+//
+// It takes no Options, so its routes are unconstrained; the input function can be used to filter them, while
+// FindRoute() and FindRoutes() take Constraints (see WithConstraints()).
+func (n *Node) WalkRoutes(origin, target string, maxLen int, fn func(route []string) bool) error {
+	origin, target, err := n.validate(origin, target, opWalkRoutes)
+
+	if err != nil {
+		return err
+	}
+
+	// words in different components cannot be connected
+	if !n.components().reachable(origin, target) {
+		return ErrNoRoute
+	}
+
+	var found bool
+
+	// wrap the input function to keep track of any route being found
+	visit := func(route []string) bool {
+		found = true
+		return fn(route)
+	}
+
+	n.getRoot().walk([]string{origin}, target, maxLen, visit)
+
+	if !found {
+		return ErrNoRoute
+	}
+
+	return nil
+}
+
+// walk method is a recursive call to explore all routes branching out from the carry slice (the route so far),
+// calling the input function with each one that reaches the target. It returns false once the input function
+// asks to stop the walk.
+func (n *Node) walk(carry []string, target string, maxLen int, fn func([]string) bool) bool {
+	// hard-limit for the carry length
+	if len(carry) >= maxLen {
+		return true
+	}
+
+	siblings, err := n.neighbors(carry[len(carry)-1])
+
+	// a word without siblings is a dead-end
+	if err != nil {
+		return true
+	}
+
+	for _, sibling := range siblings {
+		// check if the current sibling is already present as one of the items in the carry routes,
+		// and skip it if so
+		if inCarry(carry, sibling) {
+			continue
+		}
+
+		// append this word to a copy of the routes list, as it is shared with other branches
+		route := append(carry[:len(carry):len(carry)], sibling)
+
+		// a route ends once it reaches the target
+		if sibling == target {
+			if !fn(route) {
+				return false
+			}
+
+			continue
+		}
+
+		if !n.walk(route, target, maxLen, fn) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestWalkRoutes(t *testing.T) {
+	module := "Graph"
+	funcname := "WalkRoutes()"
+
+	_ = module
+	_ = funcname
+
+	type test struct {
+		name   string
+		origin string
+		target string
+		maxLen int
+		limit  int
+		wants  [][]string
+		err    error
+	}
+
+	root := New()
+	root.Add("cat", "cot", "cog", "dog", "dot", "cag", "rabi", "rare")
+
+	var tests = []test{
+		{
+			name:   "all routes up to 4 words",
+			origin: "cat",
+			target: "dog",
+			maxLen: 4,
+			wants: [][]string{
+				{"cat", "cag", "cog", "dog"},
+				{"cat", "cot", "cog", "dog"},
+				{"cat", "cot", "dot", "dog"},
+			},
+		},
+		{
+			name:   "all routes up to 6 words",
+			origin: "cat",
+			target: "dog",
+			maxLen: 6,
+			wants: [][]string{
+				{"cat", "cag", "cog", "cot", "dot", "dog"},
+				{"cat", "cag", "cog", "dog"},
+				{"cat", "cot", "cog", "dog"},
+				{"cat", "cot", "dot", "dog"},
+			},
+		},
+		{
+			name:   "stop early",
+			origin: "cat",
+			target: "dog",
+			maxLen: 6,
+			limit:  2,
+			wants: [][]string{
+				{"cat", "cag", "cog", "cot", "dot", "dog"},
+				{"cat", "cag", "cog", "dog"},
+			},
+		},
+		{
+			name:   "zero routes found -- max length too short",
+			origin: "cat",
+			target: "dog",
+			maxLen: 3,
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "zero routes found -- disconnected words",
+			origin: "cat",
+			target: "rare",
+			maxLen: 10,
+			err:    ErrNoRoute,
+		},
+		{
+			name:   "invalid call -- origin not in graph",
+			origin: "cut",
+			target: "dog",
+			maxLen: 4,
+			err:    ErrNonExistent,
+		},
+	}
+
+	var verify = func(idx int, test test) {
+		var routes [][]string
+
+		err := root.WalkRoutes(test.origin, test.target, test.maxLen, func(route []string) bool {
+			routes = append(routes, route)
+
+			return test.limit == 0 || len(routes) < test.limit
+		})
+
+		if err != nil || test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(
+					"#%v -- FAILED -- [%s] [%s] unexpected error occurred: wanted %v ; got %v -- action: %s",
+					idx,
+					module,
+					funcname,
+					test.err,
+					err,
+					test.name,
+				)
+			}
+			return
+		}
+
+		if !reflect.DeepEqual(routes, test.wants) {
+			t.Errorf(
+				"#%v -- FAILED -- [%s] [%s] output mismatch error: wanted %v ; got %v -- action: %s",
+				idx,
+				module,
+				funcname,
+				test.wants,
+				routes,
+				test.name,
+			)
+			return
+		}
+	}
+
+	for idx, test := range tests {
+		verify(idx, test)
+	}
+}
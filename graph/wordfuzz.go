@@ -1,10 +1,35 @@
 package graph
 
-// Fuzz method will take an input word and alter its characters while trying to find real words doing so
-// (by exploring populated pointers in the dictionary).
+import (
+	"sort"
+)
+
+// Fuzz method will take an input word and return the real words one single-character change away from it: the
+// ones replacing a character, the ones with one more character at the end, and the one with its last character
+// removed.
 //
-// All existing words will be aggregated into a slice and returned
+// The siblings are read from the dictionary's word-neighbor graph (built on the first call, see Build()), in
+// O(degree). They are listed by type of change: first the substitutions (by position, then by character), then
+// the longer words (by character), and then the shorter word. If the word has no siblings (or does not exist in the
+// dictionary), ErrNoRoute is returned.
 func (n *Node) Fuzz(word string) ([]string, error) {
+	matches := n.adjacency().fuzz(word)
+
+	if len(matches) == 0 {
+		return nil, ErrNoRoute
+	}
+
+	return matches, nil
+}
+
+// probe method will take an input word and alter its characters while trying to find real words doing so
+// (by exploring populated pointers in the dictionary).
+//
+// All existing words will be aggregated into a slice and returned. This is how `Fuzz()` looked up siblings before
+// the word-neighbor graph was introduced; it is now how the graph is kept up to date, as it looks up the siblings
+// of each word added to the dictionary once the graph is built (see `adjacency.insert()`). It also serves as the
+// reference for the sibling relationship that the graph must match.
+func (n *Node) probe(word string) ([]string, error) {
 	matches := []string{}
 
 	// get all nodes for each character in the input word
@@ -25,25 +50,28 @@ func (n *Node) Fuzz(word string) ([]string, error) {
 	return matches, nil
 }
 
+// WeighedFuzz method will take an input word and a target, and return a Result for each of the word's siblings
+// (as listed by `Fuzz()`), with its weight towards the target, its potential (its own number of siblings) and its
+// frequency (its count in the dictionary's Frequencies, if any).
 func (n *Node) WeighedFuzz(word, target string) ([]*Result, error) {
+	adj := n.adjacency()
+
 	// fuzz the input word
-	m, err := n.Fuzz(word)
+	m := adj.fuzz(word)
 
-	if err != nil {
-		return nil, err
+	if len(m) == 0 {
+		return nil, ErrNoRoute
 	}
 
-	out := []*Result{}
+	out := make([]*Result, 0, len(m))
 
 	// for each match, fetch the siblings, and use the target, match and siblings
 	// to create a new Result entry
 	for _, match := range m {
+		result := newResult(target, match, adj.fuzz(match))
+		result.frequency = n.frequency(match)
 
-		// error cannot be nil since the implied Find() call is done in Fuzz(), too
-		// thus, skipping it
-		siblings, _ := n.Siblings(match)
-
-		out = append(out, newResult(target, match, siblings))
+		out = append(out, result)
 	}
 
 	return out, nil
@@ -64,16 +92,16 @@ func fuzz(word string, idx int, node *Node) []string {
 	for key, val := range parent.charMap {
 
 		// ignore the same key for this run
-		if key == word[idx] {
+		if key == node.char {
 			continue
 		}
 
 		// change the character
-		new := []byte(word)
-		new[idx] = val.Byte()
+		new := []rune(word)
+		new[idx] = val.Rune()
 
 		// look it up
-		if node.Find(string(new)) {
+		if node.has(string(new)) {
 			matches = append(matches, string(new))
 		}
 	}
@@ -88,9 +116,7 @@ func expanded(word string, nodes []*Node) []string {
 
 	for _, node := range nodes[len(nodes)-1].charMap {
 		if node.isEnd {
-			new := []byte(word)
-			new = append(new, node.char)
-			out = append(out, string(new))
+			out = append(out, word+string(node.char))
 		}
 	}
 	return out
@@ -107,8 +133,8 @@ func reduced(word string, nodes []*Node) []string {
 		nextToLast := nodes[len(nodes)-2]
 
 		if nextToLast.isEnd {
-			new := []byte(word[:len(word)-1])
-			out = append(out, string(new))
+			chars := []rune(word)
+			out = append(out, string(chars[:len(chars)-1]))
 		}
 	}
 
@@ -135,3 +161,65 @@ func trimDuplicates(slice []string) []string {
 
 	return out
 }
+
+// suggest method will look up existing words one change away from an input word which does not exist in the
+// dictionary, to serve as spelling suggestions.
+//
+// Like `Fuzz()`, it explores the populated pointers in the dictionary instead of trying out every character: for
+// each position in the word, only the characters present in the graph (after the word's preceding characters) are
+// used to replace or insert a character. Removing a character and swapping two adjacent ones are also tried out.
+//
+// The returned slice is sorted, or nil if there are no suggestions.
+func (n *Node) suggest(word string) []string {
+	root := n.getRoot()
+	matches := []string{}
+
+	// look up suggestions for the word as it would be stored in the dictionary, if it is not rejected
+	if w, ok := root.normalize(word); ok {
+		word = w
+	}
+
+	chars := []rune(word)
+
+	// walk through the graph along the word's characters, for as long as they exist
+	node := root
+	for idx := 0; idx <= len(chars) && node != nil; idx++ {
+		for key := range node.charMap {
+			// insert a character before the current position
+			matches = append(matches, string(chars[:idx])+string(key)+string(chars[idx:]))
+
+			// replace the character in the current position
+			if idx < len(chars) && key != chars[idx] {
+				matches = append(matches, string(chars[:idx])+string(key)+string(chars[idx+1:]))
+			}
+		}
+
+		if idx < len(chars) {
+			node = node.charMap[chars[idx]]
+		}
+	}
+
+	for idx := 0; idx < len(chars); idx++ {
+		// remove the character in the current position
+		matches = append(matches, string(chars[:idx])+string(chars[idx+1:]))
+
+		// swap the character in the current position with the next one
+		if idx < len(chars)-1 {
+			swapped := append([]rune{}, chars...)
+			swapped[idx], swapped[idx+1] = swapped[idx+1], swapped[idx]
+			matches = append(matches, string(swapped))
+		}
+	}
+
+	var out []string
+
+	// keep only the existing words, except for the input word
+	for _, m := range trimDuplicates(matches) {
+		if m != word && root.has(m) {
+			out = append(out, m)
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}